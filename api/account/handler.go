@@ -0,0 +1,250 @@
+package account
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+func HandlerGetAccounts(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetAccounts(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{}
+		utils.MergeFilter(filter, req.Filter)
+
+		projection := bson.M{
+			"_id": 0,
+		}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.AccountFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
+
+func HandlerGetAccount(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetAccount(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{
+			"addr": req.URI.AccAddr,
+		}
+		projection := bson.M{
+			"_id": 0,
+		}
+		opts := options.FindOne().
+			SetProjection(projection)
+
+		item, err := database.AccountFindOne(context.TODO(), db, filter, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(item))
+	}
+}
+
+func HandlerGetDelegations(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetDelegations(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{
+			"acc_addr": req.URI.AccAddr,
+		}
+
+		utils.MergeFilter(filter, req.Filter)
+
+		projection := bson.M{
+			"_id": 0,
+		}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.DelegationFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
+
+func HandlerGetRedelegations(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetRedelegations(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{
+			"acc_addr": req.URI.AccAddr,
+		}
+		if req.Query.Status != "" {
+			filter["status"] = req.Query.Status
+		}
+
+		utils.MergeFilter(filter, req.Filter)
+
+		projection := bson.M{
+			"_id": 0,
+		}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.RedelegationFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
+
+func HandlerGetTransfers(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetTransfers(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{
+			"$or": bson.A{
+				bson.M{
+					"from_addr": req.URI.AccAddr,
+				},
+				bson.M{
+					"to_addr": req.URI.AccAddr,
+				},
+			},
+		}
+
+		utils.MergeFilter(filter, req.Filter)
+
+		projection := bson.M{
+			"_id": 0,
+		}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.TransferFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
+
+func HandlerGetUnbondings(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetUnbondings(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{
+			"acc_addr": req.URI.AccAddr,
+		}
+		if req.Query.Status != "" {
+			filter["status"] = req.Query.Status
+		}
+
+		utils.MergeFilter(filter, req.Filter)
+
+		projection := bson.M{
+			"_id": 0,
+		}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.UnbondingFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
+
+func HandlerGetRewardWithdrawals(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetRewardWithdrawals(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{
+			"acc_addr": req.URI.AccAddr,
+		}
+
+		utils.MergeFilter(filter, req.Filter)
+
+		projection := bson.M{
+			"_id": 0,
+		}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.RewardWithdrawalFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
@@ -0,0 +1,273 @@
+package account
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/utils"
+)
+
+var (
+	accountFields = utils.Fields{
+		"addr":          {Type: utils.FieldTypeString, Filter: true},
+		"create_height": {Type: utils.FieldTypeInt, Sort: true},
+	}
+	delegationFields = utils.Fields{
+		"height":   {Type: utils.FieldTypeInt, Sort: true},
+		"val_addr": {Type: utils.FieldTypeString, Filter: true},
+	}
+	redelegationFields = utils.Fields{
+		"completion_time": {Type: utils.FieldTypeTime, Sort: true},
+		"dst_val_addr":    {Type: utils.FieldTypeString, Filter: true},
+		"src_val_addr":    {Type: utils.FieldTypeString, Filter: true},
+		"start_height":    {Type: utils.FieldTypeInt, Sort: true},
+		"status":          {Type: utils.FieldTypeString, Filter: true},
+	}
+	rewardWithdrawalFields = utils.Fields{
+		"height": {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+	}
+	transferFields = utils.Fields{
+		"from_addr": {Type: utils.FieldTypeString, Filter: true},
+		"height":    {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+		"to_addr":   {Type: utils.FieldTypeString, Filter: true},
+	}
+	unbondingFields = utils.Fields{
+		"completion_time": {Type: utils.FieldTypeTime, Sort: true},
+		"start_height":    {Type: utils.FieldTypeInt, Sort: true},
+		"status":          {Type: utils.FieldTypeString, Filter: true},
+		"val_addr":        {Type: utils.FieldTypeString, Filter: true},
+	}
+)
+
+type RequestGetAccounts struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	Query struct {
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetAccounts(c *gin.Context) (req *RequestGetAccounts, err error) {
+	req = &RequestGetAccounts{}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = accountFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = accountFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetAccount struct {
+	URI struct {
+		AccAddr string `uri:"acc_addr"`
+	}
+}
+
+func NewRequestGetAccount(c *gin.Context) (req *RequestGetAccount, err error) {
+	req = &RequestGetAccount{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetDelegations struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	URI struct {
+		AccAddr string `uri:"acc_addr"`
+	}
+	Query struct {
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetDelegations(c *gin.Context) (req *RequestGetDelegations, err error) {
+	req = &RequestGetDelegations{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = delegationFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = delegationFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetRedelegations struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	URI struct {
+		AccAddr string `uri:"acc_addr"`
+	}
+	Query struct {
+		Status string `form:"status" binding:"omitempty,oneof=active inactive"`
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetRedelegations(c *gin.Context) (req *RequestGetRedelegations, err error) {
+	req = &RequestGetRedelegations{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = redelegationFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = redelegationFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetTransfers struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	URI struct {
+		AccAddr string `uri:"acc_addr"`
+	}
+	Query struct {
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetTransfers(c *gin.Context) (req *RequestGetTransfers, err error) {
+	req = &RequestGetTransfers{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = transferFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = transferFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetUnbondings struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	URI struct {
+		AccAddr string `uri:"acc_addr"`
+	}
+	Query struct {
+		Status string `form:"status" binding:"omitempty,oneof=active inactive"`
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetUnbondings(c *gin.Context) (req *RequestGetUnbondings, err error) {
+	req = &RequestGetUnbondings{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = unbondingFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = unbondingFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetRewardWithdrawals struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	URI struct {
+		AccAddr string `uri:"acc_addr"`
+	}
+	Query struct {
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetRewardWithdrawals(c *gin.Context) (req *RequestGetRewardWithdrawals, err error) {
+	req = &RequestGetRewardWithdrawals{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = rewardWithdrawalFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = rewardWithdrawalFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,16 @@
+package account
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func RegisterRoutes(router gin.IRouter, db *mongo.Database) {
+	router.GET("/accounts", HandlerGetAccounts(db))
+	router.GET("/accounts/:acc_addr", HandlerGetAccount(db))
+	router.GET("/accounts/:acc_addr/delegations", HandlerGetDelegations(db))
+	router.GET("/accounts/:acc_addr/redelegations", HandlerGetRedelegations(db))
+	router.GET("/accounts/:acc_addr/transfers", HandlerGetTransfers(db))
+	router.GET("/accounts/:acc_addr/unbondings", HandlerGetUnbondings(db))
+	router.GET("/accounts/:acc_addr/withdrawals", HandlerGetRewardWithdrawals(db))
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/sentinel-official/explorer/database"
 	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
 )
 
 func HandlerGetBlocks(db *mongo.Database) gin.HandlerFunc {
@@ -38,6 +39,9 @@ func HandlerGetBlocks(db *mongo.Database) gin.HandlerFunc {
 				"$lte": req.Query.ToHeight,
 			}
 		}
+
+		utils.MergeFilter(filter, req.Filter)
+
 		projection := bson.M{
 			"height":           1,
 			"time":             1,
@@ -47,16 +51,17 @@ func HandlerGetBlocks(db *mongo.Database) gin.HandlerFunc {
 		}
 		opts := options.Find().
 			SetProjection(projection).
+			SetSort(req.Sort).
 			SetSkip(req.Query.Skip).
 			SetLimit(req.Query.Limit)
 
-		items, err := database.BlockFind(context.TODO(), db, filter, opts)
+		items, page, err := database.BlockFindPage(context.TODO(), db, filter, req.Cursor, opts)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
 			return
 		}
 
-		c.JSON(http.StatusOK, types.NewResponseResult(items))
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
 	}
 }
 
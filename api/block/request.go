@@ -2,14 +2,27 @@ package block
 
 import (
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/utils"
 )
 
+var BlockFields = utils.Fields{
+	"height": {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+}
+
 type RequestGetBlocks struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
 	Query struct {
-		FromHeight int64 `form:"from_height"`
-		ToHeight   int64 `form:"to_height,default=1000000000"`
-		Skip       int64 `form:"skip" binding:"gte=0"`
-		Limit      int64 `form:"limit,default=25" binding:"gte=0,lte=100"`
+		FromHeight int64  `form:"from_height"`
+		ToHeight   int64  `form:"to_height,default=1000000000"`
+		Sort       string `form:"sort"`
+		Cursor     string `form:"cursor"`
+		Skip       int64  `form:"skip" binding:"gte=0"`
+		Limit      int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
 	}
 }
 
@@ -18,6 +31,15 @@ func NewRequestGetBlocks(c *gin.Context) (req *RequestGetBlocks, err error) {
 	if err = c.ShouldBindQuery(&req.Query); err != nil {
 		return nil, err
 	}
+	if req.Sort, err = BlockFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = BlockFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/sentinel-official/explorer/database"
 	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
 )
 
 func HandlerGetDeposits(db *mongo.Database) gin.HandlerFunc {
@@ -22,6 +23,8 @@ func HandlerGetDeposits(db *mongo.Database) gin.HandlerFunc {
 		}
 
 		filter := bson.M{}
+		utils.MergeFilter(filter, req.Filter)
+
 		projection := bson.M{}
 		opts := options.Find().
 			SetProjection(projection).
@@ -29,13 +32,13 @@ func HandlerGetDeposits(db *mongo.Database) gin.HandlerFunc {
 			SetSkip(req.Query.Skip).
 			SetLimit(req.Query.Limit)
 
-		items, err := database.DepositFind(context.TODO(), db, filter, opts)
+		items, page, err := database.DepositFindPage(context.TODO(), db, filter, req.Cursor, opts)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
 			return
 		}
 
-		c.JSON(http.StatusOK, types.NewResponseResult(items))
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
 	}
 }
 
@@ -76,11 +79,15 @@ func HandlerGetDepositEvents(db *mongo.Database) gin.HandlerFunc {
 			"type": bson.M{
 				"$in": bson.A{
 					types.EventTypeDepositAdd,
+					types.EventTypeDepositSnapshot,
 					types.EventTypeDepositSubtract,
 				},
 			},
 			"acc_address": req.URI.AccAddr,
 		}
+
+		utils.MergeFilter(filter, req.Filter)
+
 		projection := bson.M{}
 		opts := options.Find().
 			SetProjection(projection).
@@ -88,12 +95,12 @@ func HandlerGetDepositEvents(db *mongo.Database) gin.HandlerFunc {
 			SetSkip(req.Query.Skip).
 			SetLimit(req.Query.Limit)
 
-		items, err := database.EventFind(context.TODO(), db, filter, opts)
+		items, page, err := database.EventFindPage(context.TODO(), db, filter, req.Cursor, opts)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
 			return
 		}
 
-		c.JSON(http.StatusOK, types.NewResponseResult(items))
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
 	}
 }
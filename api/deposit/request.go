@@ -7,13 +7,22 @@ import (
 	"github.com/sentinel-official/explorer/utils"
 )
 
+var DepositFields = utils.Fields{
+	"addr":         {Type: utils.FieldTypeString, Filter: true},
+	"coins.amount": {Type: utils.FieldTypeString, Sort: true},
+	"coins.denom":  {Type: utils.FieldTypeString, Sort: true},
+}
+
 type RequestGetDeposits struct {
-	Sort bson.D
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
 
 	Query struct {
-		Sort  string `form:"sort"`
-		Skip  int64  `form:"skip,default=0" binding:"gte=0"`
-		Limit int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip,default=0" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
 	}
 }
 
@@ -22,12 +31,13 @@ func NewRequestGetDeposits(c *gin.Context) (req *RequestGetDeposits, err error)
 	if err = c.ShouldBindQuery(&req.Query); err != nil {
 		return nil, err
 	}
-
-	allowed := []string{
-		"coins.amount,coins.denom",
-		"-coins.amount,coins.denom",
+	if req.Sort, err = DepositFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = DepositFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
 	}
-	if req.Sort, err = utils.ParseQuerySort(allowed, req.Query.Sort); err != nil {
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
 		return nil, err
 	}
 
@@ -50,15 +60,18 @@ func NewRequestGetDeposit(c *gin.Context) (req *RequestGetDeposit, err error) {
 }
 
 type RequestGetDepositEvents struct {
-	Sort bson.D
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
 
 	URI struct {
 		AccAddr string `uri:"acc_addr"`
 	}
 	Query struct {
-		Sort  string `form:"sort"`
-		Skip  int64  `form:"skip,default=0" binding:"gte=0"`
-		Limit int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip,default=0" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
 	}
 }
 
@@ -70,12 +83,13 @@ func NewRequestGetDepositEvents(c *gin.Context) (req *RequestGetDepositEvents, e
 	if err = c.ShouldBindQuery(&req.Query); err != nil {
 		return nil, err
 	}
-
-	allowed := []string{
-		"height",
-		"-height",
+	if req.Sort, err = utils.EventFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = utils.EventFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
 	}
-	if req.Sort, err = utils.ParseQuerySort(allowed, req.Query.Sort); err != nil {
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,53 @@
+package failure
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+func HandlerGetFailures(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetFailures(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{}
+		if req.Query.MsgType != "" {
+			filter["msg_type"] = req.Query.MsgType
+		}
+		if req.URI.AccAddr != "" {
+			filter["acc_addr"] = req.URI.AccAddr
+		}
+		if req.URI.NodeAddr != "" {
+			filter["node_addr"] = req.URI.NodeAddr
+		}
+
+		utils.MergeFilter(filter, req.Filter)
+
+		projection := bson.M{}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.TxFailureFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
@@ -0,0 +1,53 @@
+package failure
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/utils"
+)
+
+var failureFields = utils.Fields{
+	"acc_addr":  {Type: utils.FieldTypeString, Filter: true},
+	"height":    {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+	"node_addr": {Type: utils.FieldTypeString, Filter: true},
+	"tx_hash":   {Type: utils.FieldTypeString, Filter: true},
+}
+
+type RequestGetFailures struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+	URI    struct {
+		AccAddr  string `uri:"acc_addr"`
+		NodeAddr string `uri:"node_addr"`
+	}
+	Query struct {
+		MsgType string `form:"msg_type"`
+		Cursor  string `form:"cursor"`
+		Sort    string `form:"sort,default=-height"`
+		Skip    int64  `form:"skip" binding:"gte=0"`
+		Limit   int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetFailures(c *gin.Context) (req *RequestGetFailures, err error) {
+	req = &RequestGetFailures{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = failureFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = failureFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
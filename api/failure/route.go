@@ -0,0 +1,14 @@
+package failure
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func RegisterRoutes(router gin.IRouter, db *mongo.Database) {
+	router.GET("/accounts/:acc_addr/failures", HandlerGetFailures(db))
+
+	router.GET("/failures", HandlerGetFailures(db))
+
+	router.GET("/nodes/:node_addr/failures", HandlerGetFailures(db))
+}
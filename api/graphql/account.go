@@ -0,0 +1,63 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/models"
+)
+
+type accountResolver struct {
+	db    *mongo.Database
+	v     *models.Account
+	batch []*models.Account
+}
+
+func newAccountResolver(db *mongo.Database, v *models.Account, batch []*models.Account) *accountResolver {
+	if batch == nil {
+		batch = []*models.Account{v}
+	}
+
+	return &accountResolver{
+		db:    db,
+		v:     v,
+		batch: batch,
+	}
+}
+
+func (r *accountResolver) Addr() string                  { return r.v.Addr }
+func (r *accountResolver) CreateHeight() Long            { return Long(r.v.CreateHeight) }
+func (r *accountResolver) CreateTimestamp() graphql.Time { return newTime(r.v.CreateTimestamp) }
+func (r *accountResolver) CreateTxHash() string          { return r.v.CreateTxHash }
+
+func (r *accountResolver) Deposit(ctx context.Context) (*depositResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Account) string { return v.Addr })
+
+	item, batch, ok, err := loadersFrom(ctx).deposits.LoadBatch(ctx, r.v.Addr, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newDepositResolver(r.db, item, batch), nil
+}
+
+func (r *accountResolver) Sessions(ctx context.Context, args statusPageArgs) ([]*sessionResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Account) string { return v.Addr })
+
+	return loadSessions(ctx, r.db, "acc_addr", r.v.Addr, keys, withStatus(bson.M{}, args.Status), args.Limit, args.Skip)
+}
+
+func (r *accountResolver) Subscriptions(ctx context.Context, args statusPageArgs) ([]*subscriptionResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Account) string { return v.Addr })
+
+	return loadSubscriptions(ctx, r.db, "acc_addr", r.v.Addr, keys, withStatus(bson.M{}, args.Status), args.Limit, args.Skip)
+}
+
+func (r *accountResolver) Txs(ctx context.Context, args pageArgs) ([]*txResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Account) string { return v.Addr })
+
+	return loadTxs(ctx, r.db, "signers", r.v.Addr, keys, nil, bson.D{{Key: "height", Value: -1}}, args.Limit, args.Skip)
+}
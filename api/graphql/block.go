@@ -0,0 +1,52 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/models"
+)
+
+type blockResolver struct {
+	db    *mongo.Database
+	v     *models.Block
+	batch []*models.Block
+}
+
+func newBlockResolver(db *mongo.Database, v *models.Block, batch []*models.Block) *blockResolver {
+	if batch == nil {
+		batch = []*models.Block{v}
+	}
+
+	return &blockResolver{
+		db:    db,
+		v:     v,
+		batch: batch,
+	}
+}
+
+func newBlockResolvers(db *mongo.Database, items []*models.Block) []*blockResolver {
+	resolvers := make([]*blockResolver, 0, len(items))
+	for _, item := range items {
+		resolvers = append(resolvers, newBlockResolver(db, item, items))
+	}
+
+	return resolvers
+}
+
+func (r *blockResolver) Height() Long            { return Long(r.v.Height) }
+func (r *blockResolver) ID() string              { return r.v.ID }
+func (r *blockResolver) ChainID() string         { return r.v.ChainID }
+func (r *blockResolver) Time() graphql.Time      { return newTime(r.v.Time) }
+func (r *blockResolver) Duration() Long          { return Long(r.v.Duration) }
+func (r *blockResolver) NumTxs() int32           { return int32(r.v.NumTxs) }
+func (r *blockResolver) ProposerAddress() string { return r.v.ProposerAddress }
+
+func (r *blockResolver) Txs(ctx context.Context, args pageArgs) ([]*txResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Block) int64 { return v.Height })
+
+	return loadTxs(ctx, r.db, "height", r.v.Height, keys, nil, bson.D{{Key: "index", Value: 1}}, args.Limit, args.Skip)
+}
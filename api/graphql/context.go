@@ -0,0 +1,53 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type contextKey int
+
+const (
+	contextKeyComplexity contextKey = iota
+	contextKeyLoaders
+)
+
+// complexity is the number of documents a request may still read. A field is
+// charged the most documents it can read before its query runs, so a request
+// which nests the lists too deep fails instead of loading the database.
+type complexity struct {
+	mu   sync.Mutex
+	left int
+}
+
+func (c *complexity) charge(n int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if n > c.left {
+		return fmt.Errorf("query exceeds the complexity limit of %d documents", maxComplexity)
+	}
+
+	c.left -= n
+	return nil
+}
+
+// newContext returns the context of a request with its own complexity budget
+// and document loaders.
+func newContext(ctx context.Context, db *mongo.Database) context.Context {
+	ctx = context.WithValue(ctx, contextKeyComplexity, &complexity{left: maxComplexity})
+	ctx = context.WithValue(ctx, contextKeyLoaders, newLoaders(db))
+
+	return ctx
+}
+
+func complexityFrom(ctx context.Context) *complexity {
+	return ctx.Value(contextKeyComplexity).(*complexity)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(contextKeyLoaders).(*loaders)
+}
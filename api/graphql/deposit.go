@@ -0,0 +1,55 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+type depositResolver struct {
+	db    *mongo.Database
+	v     *models.Deposit
+	batch []*models.Deposit
+}
+
+func newDepositResolver(db *mongo.Database, v *models.Deposit, batch []*models.Deposit) *depositResolver {
+	if batch == nil {
+		batch = []*models.Deposit{v}
+	}
+
+	return &depositResolver{
+		db:    db,
+		v:     v,
+		batch: batch,
+	}
+}
+
+func newDepositResolvers(db *mongo.Database, items []*models.Deposit) []*depositResolver {
+	resolvers := make([]*depositResolver, 0, len(items))
+	for _, item := range items {
+		resolvers = append(resolvers, newDepositResolver(db, item, items))
+	}
+
+	return resolvers
+}
+
+func (r *depositResolver) Addr() string            { return r.v.Addr }
+func (r *depositResolver) Coins() types.Coins      { return r.v.Coins }
+func (r *depositResolver) Height() Long            { return Long(r.v.Height) }
+func (r *depositResolver) Timestamp() graphql.Time { return newTime(r.v.Timestamp) }
+func (r *depositResolver) TxHash() string          { return r.v.TxHash }
+
+func (r *depositResolver) Account(ctx context.Context) (*accountResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Deposit) string { return v.Addr })
+
+	item, batch, ok, err := loadersFrom(ctx).accounts.LoadBatch(ctx, r.v.Addr, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newAccountResolver(r.db, item, batch), nil
+}
@@ -0,0 +1,135 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+type eventResolver struct {
+	db    *mongo.Database
+	v     *models.Event
+	batch []*models.Event
+}
+
+func newEventResolvers(db *mongo.Database, items []*models.Event) []*eventResolver {
+	resolvers := make([]*eventResolver, 0, len(items))
+	for _, item := range items {
+		resolvers = append(resolvers, &eventResolver{
+			db:    db,
+			v:     item,
+			batch: items,
+		})
+	}
+
+	return resolvers
+}
+
+// loadEvents returns the events of a relation field, along with the ones of the
+// parents of its batch. The field and the filter should be backed by an index of
+// the collection along with the sort.
+func loadEvents[K comparable](ctx context.Context, db *mongo.Database, field string, key K, keys []K, filter bson.M, sort bson.D, limit, skip int32) ([]*eventResolver, error) {
+	items, err := loadRelation[K, *models.Event](ctx, db, database.EventCollectionName, field, key, keys, filter, sort, limit, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	return newEventResolvers(db, items), nil
+}
+
+func (r *eventResolver) Type() string                { return r.v.Type }
+func (r *eventResolver) Height() Long                { return Long(r.v.Height) }
+func (r *eventResolver) Timestamp() graphql.Time     { return newTime(r.v.Timestamp) }
+func (r *eventResolver) TxHash() string              { return r.v.TxHash }
+func (r *eventResolver) AccAddr() string             { return r.v.AccAddr }
+func (r *eventResolver) Bandwidth() *types.Bandwidth { return r.v.Bandwidth }
+func (r *eventResolver) Coins() types.Coins          { return r.v.Coins }
+func (r *eventResolver) NodeAddr() string            { return r.v.NodeAddr }
+func (r *eventResolver) PlanID() Long                { return Long(r.v.PlanID) }
+func (r *eventResolver) ProvAddr() string            { return r.v.ProvAddr }
+func (r *eventResolver) SessionID() Long             { return Long(r.v.SessionID) }
+func (r *eventResolver) Status() string              { return r.v.Status }
+func (r *eventResolver) SubscriptionID() Long        { return Long(r.v.SubscriptionID) }
+
+func (r *eventResolver) Account(ctx context.Context) (*accountResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Event) string { return v.AccAddr })
+
+	item, batch, ok, err := loadersFrom(ctx).accounts.LoadBatch(ctx, r.v.AccAddr, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newAccountResolver(r.db, item, batch), nil
+}
+
+func (r *eventResolver) Node(ctx context.Context) (*nodeResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Event) string { return v.NodeAddr })
+
+	item, batch, ok, err := loadersFrom(ctx).nodes.LoadBatch(ctx, r.v.NodeAddr, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newNodeResolver(r.db, item, batch), nil
+}
+
+func (r *eventResolver) Plan(ctx context.Context) (*planResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Event) uint64 { return v.PlanID })
+
+	item, batch, ok, err := loadersFrom(ctx).plans.LoadBatch(ctx, r.v.PlanID, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newPlanResolver(r.db, item, batch), nil
+}
+
+func (r *eventResolver) Provider(ctx context.Context) (*providerResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Event) string { return v.ProvAddr })
+
+	item, batch, ok, err := loadersFrom(ctx).providers.LoadBatch(ctx, r.v.ProvAddr, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newProviderResolver(r.db, item, batch), nil
+}
+
+func (r *eventResolver) Session(ctx context.Context) (*sessionResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Event) uint64 { return v.SessionID })
+
+	item, batch, ok, err := loadersFrom(ctx).sessions.LoadBatch(ctx, r.v.SessionID, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newSessionResolver(r.db, item, batch), nil
+}
+
+func (r *eventResolver) Subscription(ctx context.Context) (*subscriptionResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Event) uint64 { return v.SubscriptionID })
+
+	item, batch, ok, err := loadersFrom(ctx).subscriptions.LoadBatch(ctx, r.v.SubscriptionID, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newSubscriptionResolver(r.db, item, batch), nil
+}
+
+func (r *eventResolver) Tx(ctx context.Context) (*txResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Event) string { return v.TxHash })
+
+	item, batch, ok, err := loadersFrom(ctx).txs.LoadBatch(ctx, r.v.TxHash, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newTxResolver(r.db, item, batch), nil
+}
@@ -0,0 +1,28 @@
+package graphql
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+// HandlerPostGraphQL executes a query against the schema. The result is the
+// GraphQL response as is, with the errors of the fields next to the data.
+func HandlerPostGraphQL(db *mongo.Database, schema *graphql.Schema) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestPostGraphQL(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		ctx := newContext(c.Request.Context(), db)
+		res := schema.Exec(ctx, req.Body.Query, req.Body.OperationName, req.Body.Variables)
+
+		c.JSON(http.StatusOK, res)
+	}
+}
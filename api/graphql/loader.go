@@ -0,0 +1,363 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+)
+
+// loader caches the documents of a collection by their key for a request. The
+// resolver of a list item loads its key along with the keys of its siblings,
+// so that resolving a relation of every item of a list takes a single query
+// instead of one per item.
+type loader[K comparable, V any] struct {
+	mu      sync.Mutex
+	fetch   func(ctx context.Context, keys []K) ([]V, error)
+	key     func(v V) K
+	items   map[K]V
+	fetched map[K]bool
+}
+
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) ([]V, error), key func(v V) K) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:   fetch,
+		key:     key,
+		items:   make(map[K]V),
+		fetched: make(map[K]bool),
+	}
+}
+
+// Load returns the document of the key. The second value reports whether the
+// document exists.
+func (l *loader[K, V]) Load(ctx context.Context, key K) (v V, ok bool, err error) {
+	v, _, ok, err = l.LoadBatch(ctx, key, []K{key})
+	return v, ok, err
+}
+
+// LoadBatch returns the document of the key along with the documents of the
+// keys of its batch, fetching the ones which are not cached yet. The documents
+// of the batch are in turn the batch of the relations of the document.
+func (l *loader[K, V]) LoadBatch(ctx context.Context, key K, keys []K) (v V, batch []V, ok bool, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err = l.load(ctx, keys); err != nil {
+		return v, nil, false, err
+	}
+
+	v, ok = l.items[key]
+	return v, l.get(keys), ok, nil
+}
+
+// LoadMany returns the existing documents of the keys, in the order of the
+// keys and without the duplicates.
+func (l *loader[K, V]) LoadMany(ctx context.Context, keys []K) ([]V, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.load(ctx, keys); err != nil {
+		return nil, err
+	}
+
+	return l.get(keys), nil
+}
+
+func (l *loader[K, V]) get(keys []K) []V {
+	var (
+		items = make([]V, 0, len(keys))
+		seen  = make(map[K]bool)
+	)
+
+	for _, key := range keys {
+		v, ok := l.items[key]
+		if !ok || seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		items = append(items, v)
+	}
+
+	return items
+}
+
+func (l *loader[K, V]) load(ctx context.Context, keys []K) error {
+	var (
+		zero    K
+		missing []K
+		seen    = make(map[K]bool)
+	)
+
+	for _, key := range keys {
+		if key == zero || l.fetched[key] || seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		missing = append(missing, key)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if err := complexityFrom(ctx).charge(len(missing)); err != nil {
+		return err
+	}
+
+	items, err := l.fetch(ctx, missing)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range missing {
+		l.fetched[key] = true
+	}
+	for _, item := range items {
+		l.items[l.key(item)] = item
+	}
+
+	return nil
+}
+
+// loaders are the loaders of the documents referenced by the relations.
+type loaders struct {
+	accounts      *loader[string, *models.Account]
+	blocks        *loader[int64, *models.Block]
+	deposits      *loader[string, *models.Deposit]
+	nodes         *loader[string, *models.Node]
+	plans         *loader[uint64, *models.Plan]
+	providers     *loader[string, *models.Provider]
+	sessions      *loader[uint64, *models.Session]
+	subscriptions *loader[uint64, *models.Subscription]
+	txs           *loader[string, *models.Tx]
+	relations     *relations
+}
+
+func newLoaders(db *mongo.Database) *loaders {
+	return &loaders{
+		accounts: newLoader(
+			func(ctx context.Context, keys []string) ([]*models.Account, error) {
+				return database.AccountFind(ctx, db, bson.M{"addr": bson.M{"$in": keys}})
+			},
+			func(v *models.Account) string { return v.Addr },
+		),
+		blocks: newLoader(
+			func(ctx context.Context, keys []int64) ([]*models.Block, error) {
+				return database.BlockFind(ctx, db, bson.M{"height": bson.M{"$in": keys}})
+			},
+			func(v *models.Block) int64 { return v.Height },
+		),
+		deposits: newLoader(
+			func(ctx context.Context, keys []string) ([]*models.Deposit, error) {
+				return database.DepositFind(ctx, db, bson.M{"addr": bson.M{"$in": keys}})
+			},
+			func(v *models.Deposit) string { return v.Addr },
+		),
+		nodes: newLoader(
+			func(ctx context.Context, keys []string) ([]*models.Node, error) {
+				return database.NodeFind(ctx, db, bson.M{"addr": bson.M{"$in": keys}})
+			},
+			func(v *models.Node) string { return v.Addr },
+		),
+		plans: newLoader(
+			func(ctx context.Context, keys []uint64) ([]*models.Plan, error) {
+				return database.PlanFind(ctx, db, bson.M{"id": bson.M{"$in": keys}})
+			},
+			func(v *models.Plan) uint64 { return v.ID },
+		),
+		providers: newLoader(
+			func(ctx context.Context, keys []string) ([]*models.Provider, error) {
+				return database.ProviderFind(ctx, db, bson.M{"addr": bson.M{"$in": keys}})
+			},
+			func(v *models.Provider) string { return v.Addr },
+		),
+		sessions: newLoader(
+			func(ctx context.Context, keys []uint64) ([]*models.Session, error) {
+				return database.SessionFind(ctx, db, bson.M{"id": bson.M{"$in": keys}})
+			},
+			func(v *models.Session) uint64 { return v.ID },
+		),
+		subscriptions: newLoader(
+			func(ctx context.Context, keys []uint64) ([]*models.Subscription, error) {
+				return database.SubscriptionFind(ctx, db, bson.M{"id": bson.M{"$in": keys}})
+			},
+			func(v *models.Subscription) uint64 { return v.ID },
+		),
+		txs: newLoader(
+			func(ctx context.Context, keys []string) ([]*models.Tx, error) {
+				return database.TxFind(ctx, db, bson.M{"hash": bson.M{"$in": keys}})
+			},
+			func(v *models.Tx) string { return v.Hash },
+		),
+		relations: &relations{
+			lists: make(map[relationKey]interface{}),
+		},
+	}
+}
+
+// relationKey identifies the lists of a relation loaded with the same
+// arguments.
+type relationKey struct {
+	collection string
+	field      string
+	filter     string
+	sort       string
+	limit      int32
+	skip       int32
+}
+
+// relations caches the lists of the one-to-many relations for a request, each
+// entry holding a map of the lists by the key of their parent.
+type relations struct {
+	mu    sync.Mutex
+	lists map[relationKey]interface{}
+}
+
+// loadRelation returns the list of the relation of the parent key, the
+// documents of the collection whose field matches the key. The lists of the
+// keys of its batch are loaded along with it in a single aggregation, which has
+// a sub-pipeline of every key so that each one reads at most skip+limit
+// documents through the index of the field.
+func loadRelation[K comparable, V any](
+	ctx context.Context, db *mongo.Database, collection, field string,
+	key K, keys []K, filter bson.M, sort bson.D, limit, skip int32,
+) ([]V, error) {
+	r := loadersFrom(ctx).relations
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rk := relationKey{
+		collection: collection,
+		field:      field,
+		filter:     fmt.Sprint(filter),
+		sort:       fmt.Sprint(sort),
+		limit:      limit,
+		skip:       skip,
+	}
+
+	lists, ok := r.lists[rk].(map[K][]V)
+	if !ok {
+		lists = make(map[K][]V)
+		r.lists[rk] = lists
+	}
+	if v, ok := lists[key]; ok {
+		return v, nil
+	}
+
+	var (
+		missing []K
+		seen    = map[K]bool{key: true}
+	)
+
+	missing = append(missing, key)
+	for _, k := range keys {
+		if _, ok := lists[k]; ok || seen[k] {
+			continue
+		}
+
+		seen[k] = true
+		missing = append(missing, k)
+	}
+
+	if err := chargePage(ctx, limit, skip, len(missing)); err != nil {
+		return nil, err
+	}
+
+	pipeline := relationPipeline(collection, field, missing, filter, sort, limit, skip)
+
+	cursor, err := database.Aggregate(ctx, db.Collection(collection), pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cursor.Close(ctx)
+
+	fetched := make(map[K][]V, len(missing))
+	for cursor.Next(ctx) {
+		var parent struct {
+			Key K `bson:"_parent"`
+		}
+		if err := cursor.Decode(&parent); err != nil {
+			return nil, err
+		}
+
+		var v V
+		if err := cursor.Decode(&v); err != nil {
+			return nil, err
+		}
+
+		fetched[parent.Key] = append(fetched[parent.Key], v)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, k := range missing {
+		if fetched[k] == nil {
+			fetched[k] = []V{}
+		}
+
+		lists[k] = fetched[k]
+	}
+
+	return lists[key], nil
+}
+
+// batchKeys returns the keys of the items, which are loaded together by the
+// resolvers of a list.
+func batchKeys[T any, K comparable](items []T, key func(v T) K) []K {
+	keys := make([]K, 0, len(items))
+	for _, item := range items {
+		keys = append(keys, key(item))
+	}
+
+	return keys
+}
+
+// relationPipeline returns the aggregation of the lists of the keys, where the
+// documents of each list are tagged with their key in the _parent field.
+func relationPipeline[K comparable](collection, field string, keys []K, filter bson.M, sort bson.D, limit, skip int32) []bson.M {
+	stages := func(k K) []bson.M {
+		match := bson.M{
+			field: k,
+		}
+		for name, value := range filter {
+			match[name] = value
+		}
+
+		items := []bson.M{
+			{"$match": match},
+		}
+		if sort != nil {
+			items = append(items, bson.M{"$sort": sort})
+		}
+
+		return append(
+			items,
+			bson.M{"$skip": int64(skip)},
+			bson.M{"$limit": int64(limit)},
+			bson.M{"$addFields": bson.M{"_parent": k}},
+		)
+	}
+
+	pipeline := stages(keys[0])
+	for _, k := range keys[1:] {
+		pipeline = append(
+			pipeline,
+			bson.M{
+				"$unionWith": bson.M{
+					"coll":     collection,
+					"pipeline": stages(k),
+				},
+			},
+		)
+	}
+
+	return pipeline
+}
@@ -0,0 +1,61 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestRelationPipeline(t *testing.T) {
+	filter := bson.M{"status": "active"}
+	sort := bson.D{{Key: "id", Value: -1}}
+
+	pipeline := relationPipeline("sessions", "node_addr", []string{"a", "b", "c"}, filter, sort, 10, 5)
+
+	// The stages of the first key, followed by a union with each other key.
+	if len(pipeline) != 5+2 {
+		t.Fatalf("got %d stages, want 7", len(pipeline))
+	}
+
+	match := pipeline[0]["$match"].(bson.M)
+	if match["node_addr"] != "a" || match["status"] != "active" {
+		t.Fatalf("got match %v", match)
+	}
+	if pipeline[2]["$skip"] != int64(5) || pipeline[3]["$limit"] != int64(10) {
+		t.Fatalf("got skip %v and limit %v", pipeline[2]["$skip"], pipeline[3]["$limit"])
+	}
+
+	for i, key := range []string{"b", "c"} {
+		union := pipeline[5+i]["$unionWith"].(bson.M)
+		if union["coll"] != "sessions" {
+			t.Fatalf("got collection %v", union["coll"])
+		}
+
+		stages := union["pipeline"].([]bson.M)
+		if stages[0]["$match"].(bson.M)["node_addr"] != key {
+			t.Fatalf("got match %v, want the key %s", stages[0]["$match"], key)
+		}
+		if stages[len(stages)-1]["$addFields"].(bson.M)["_parent"] != key {
+			t.Fatalf("got parent %v, want %s", stages[len(stages)-1]["$addFields"], key)
+		}
+	}
+
+	if _, ok := filter["node_addr"]; ok {
+		t.Fatal("expected the filter not to be modified")
+	}
+}
+
+func TestChargePageSkip(t *testing.T) {
+	ctx := newContext(context.Background(), nil)
+
+	if err := chargePage(ctx, maxLimit, maxComplexity, 1); err == nil {
+		t.Fatal("expected the skipped documents to be charged")
+	}
+	if err := chargePage(ctx, 10, 90, 2); err != nil {
+		t.Fatal(err)
+	}
+	if left := complexityFrom(ctx).left; left != maxComplexity-200 {
+		t.Fatalf("got %d documents left, want %d", left, maxComplexity-200)
+	}
+}
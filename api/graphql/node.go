@@ -0,0 +1,91 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+	nodetypes "github.com/sentinel-official/explorer/types/node"
+)
+
+type nodeResolver struct {
+	db    *mongo.Database
+	v     *models.Node
+	batch []*models.Node
+}
+
+func newNodeResolver(db *mongo.Database, v *models.Node, batch []*models.Node) *nodeResolver {
+	if batch == nil {
+		batch = []*models.Node{v}
+	}
+
+	return &nodeResolver{
+		db:    db,
+		v:     v,
+		batch: batch,
+	}
+}
+
+func newNodeResolvers(db *mongo.Database, items []*models.Node) []*nodeResolver {
+	resolvers := make([]*nodeResolver, 0, len(items))
+	for _, item := range items {
+		resolvers = append(resolvers, newNodeResolver(db, item, items))
+	}
+
+	return resolvers
+}
+
+func (r *nodeResolver) Addr() string                    { return r.v.Addr }
+func (r *nodeResolver) Moniker() string                 { return r.v.Moniker }
+func (r *nodeResolver) RemoteURL() string               { return r.v.RemoteURL }
+func (r *nodeResolver) Type() Long                      { return Long(r.v.Type) }
+func (r *nodeResolver) Version() string                 { return r.v.Version }
+func (r *nodeResolver) Peers() int32                    { return int32(r.v.Peers) }
+func (r *nodeResolver) Location() *nodetypes.Location   { return &r.v.Location }
+func (r *nodeResolver) InternetSpeed() *types.Bandwidth { return &r.v.InternetSpeed }
+func (r *nodeResolver) GigabytePrices() types.Coins     { return r.v.GigabytePrices }
+func (r *nodeResolver) HourlyPrices() types.Coins       { return r.v.HourlyPrices }
+func (r *nodeResolver) RegisterHeight() Long            { return Long(r.v.RegisterHeight) }
+func (r *nodeResolver) RegisterTimestamp() graphql.Time { return newTime(r.v.RegisterTimestamp) }
+func (r *nodeResolver) RegisterTxHash() string          { return r.v.RegisterTxHash }
+func (r *nodeResolver) Status() string                  { return r.v.Status }
+func (r *nodeResolver) StatusHeight() Long              { return Long(r.v.StatusHeight) }
+func (r *nodeResolver) StatusTimestamp() graphql.Time   { return newTime(r.v.StatusTimestamp) }
+func (r *nodeResolver) StatusTxHash() string            { return r.v.StatusTxHash }
+
+func (r *nodeResolver) Events(ctx context.Context, args pageArgs) ([]*eventResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Node) string { return v.Addr })
+
+	filter := bson.M{
+		"type": bson.M{
+			"$in": bson.A{
+				types.EventTypeNodeUpdateDetails,
+				types.EventTypeNodeUpdateStatus,
+			},
+		},
+	}
+
+	return loadEvents(ctx, r.db, "node_addr", r.v.Addr, keys, filter, bson.D{{Key: "timestamp", Value: -1}}, args.Limit, args.Skip)
+}
+
+func (r *nodeResolver) Plans(ctx context.Context, args statusPageArgs) ([]*planResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Node) string { return v.Addr })
+
+	return loadPlans(ctx, r.db, "node_addrs", r.v.Addr, keys, withStatus(bson.M{}, args.Status), args.Limit, args.Skip)
+}
+
+func (r *nodeResolver) Sessions(ctx context.Context, args statusPageArgs) ([]*sessionResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Node) string { return v.Addr })
+
+	return loadSessions(ctx, r.db, "node_addr", r.v.Addr, keys, withStatus(bson.M{}, args.Status), args.Limit, args.Skip)
+}
+
+func (r *nodeResolver) Subscriptions(ctx context.Context, args statusPageArgs) ([]*subscriptionResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Node) string { return v.Addr })
+
+	return loadSubscriptions(ctx, r.db, "node_addr", r.v.Addr, keys, withStatus(bson.M{}, args.Status), args.Limit, args.Skip)
+}
@@ -0,0 +1,115 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+type planResolver struct {
+	db    *mongo.Database
+	v     *models.Plan
+	batch []*models.Plan
+}
+
+func newPlanResolver(db *mongo.Database, v *models.Plan, batch []*models.Plan) *planResolver {
+	if batch == nil {
+		batch = []*models.Plan{v}
+	}
+
+	return &planResolver{
+		db:    db,
+		v:     v,
+		batch: batch,
+	}
+}
+
+func newPlanResolvers(db *mongo.Database, items []*models.Plan) []*planResolver {
+	resolvers := make([]*planResolver, 0, len(items))
+	for _, item := range items {
+		resolvers = append(resolvers, newPlanResolver(db, item, items))
+	}
+
+	return resolvers
+}
+
+func findPlans(ctx context.Context, db *mongo.Database, filter bson.M, limit, skip int32) ([]*planResolver, error) {
+	opts, err := findOptions(ctx, nil, limit, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := database.PlanFind(ctx, db, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPlanResolvers(db, items), nil
+}
+
+// loadPlans returns the plans of a relation field, along with the ones of the
+// parents of its batch.
+func loadPlans[K comparable](ctx context.Context, db *mongo.Database, field string, key K, keys []K, filter bson.M, limit, skip int32) ([]*planResolver, error) {
+	items, err := loadRelation[K, *models.Plan](ctx, db, database.PlanCollectionName, field, key, keys, filter, nil, limit, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPlanResolvers(db, items), nil
+}
+
+func (r *planResolver) ID() Long                      { return Long(r.v.ID) }
+func (r *planResolver) ProvAddr() string              { return r.v.ProvAddr }
+func (r *planResolver) Duration() Long                { return Long(r.v.Duration) }
+func (r *planResolver) Gigabytes() Long               { return Long(r.v.Gigabytes) }
+func (r *planResolver) Prices() types.Coins           { return r.v.Prices }
+func (r *planResolver) CreateHeight() Long            { return Long(r.v.CreateHeight) }
+func (r *planResolver) CreateTimestamp() graphql.Time { return newTime(r.v.CreateTimestamp) }
+func (r *planResolver) CreateTxHash() string          { return r.v.CreateTxHash }
+func (r *planResolver) Status() string                { return r.v.Status }
+func (r *planResolver) StatusHeight() Long            { return Long(r.v.StatusHeight) }
+func (r *planResolver) StatusTimestamp() graphql.Time { return newTime(r.v.StatusTimestamp) }
+func (r *planResolver) StatusTxHash() string          { return r.v.StatusTxHash }
+
+// Nodes returns the nodes linked to the plan, loaded along with the ones of the
+// other plans of the batch.
+func (r *planResolver) Nodes(ctx context.Context) ([]*nodeResolver, error) {
+	var keys []string
+	for _, item := range r.batch {
+		keys = append(keys, item.NodeAddrs...)
+	}
+
+	if _, err := loadersFrom(ctx).nodes.LoadMany(ctx, keys); err != nil {
+		return nil, err
+	}
+
+	items, err := loadersFrom(ctx).nodes.LoadMany(ctx, r.v.NodeAddrs)
+	if err != nil {
+		return nil, err
+	}
+
+	return newNodeResolvers(r.db, items), nil
+}
+
+func (r *planResolver) Provider(ctx context.Context) (*providerResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Plan) string { return v.ProvAddr })
+
+	item, batch, ok, err := loadersFrom(ctx).providers.LoadBatch(ctx, r.v.ProvAddr, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newProviderResolver(r.db, item, batch), nil
+}
+
+func (r *planResolver) Subscriptions(ctx context.Context, args statusPageArgs) ([]*subscriptionResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Plan) uint64 { return v.ID })
+
+	return loadSubscriptions(ctx, r.db, "plan_id", r.v.ID, keys, withStatus(bson.M{}, args.Status), args.Limit, args.Skip)
+}
@@ -0,0 +1,57 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/models"
+)
+
+type providerResolver struct {
+	db    *mongo.Database
+	v     *models.Provider
+	batch []*models.Provider
+}
+
+func newProviderResolver(db *mongo.Database, v *models.Provider, batch []*models.Provider) *providerResolver {
+	if batch == nil {
+		batch = []*models.Provider{v}
+	}
+
+	return &providerResolver{
+		db:    db,
+		v:     v,
+		batch: batch,
+	}
+}
+
+func newProviderResolvers(db *mongo.Database, items []*models.Provider) []*providerResolver {
+	resolvers := make([]*providerResolver, 0, len(items))
+	for _, item := range items {
+		resolvers = append(resolvers, newProviderResolver(db, item, items))
+	}
+
+	return resolvers
+}
+
+func (r *providerResolver) Addr() string                    { return r.v.Addr }
+func (r *providerResolver) Name() string                    { return r.v.Name }
+func (r *providerResolver) Identity() string                { return r.v.Identity }
+func (r *providerResolver) Website() string                 { return r.v.Website }
+func (r *providerResolver) Description() string             { return r.v.Description }
+func (r *providerResolver) RegisterHeight() Long            { return Long(r.v.RegisterHeight) }
+func (r *providerResolver) RegisterTimestamp() graphql.Time { return newTime(r.v.RegisterTimestamp) }
+func (r *providerResolver) RegisterTxHash() string          { return r.v.RegisterTxHash }
+func (r *providerResolver) Status() string                  { return r.v.Status }
+func (r *providerResolver) StatusHeight() Long              { return Long(r.v.StatusHeight) }
+func (r *providerResolver) StatusTimestamp() graphql.Time   { return newTime(r.v.StatusTimestamp) }
+func (r *providerResolver) StatusTxHash() string            { return r.v.StatusTxHash }
+
+func (r *providerResolver) Plans(ctx context.Context, args statusPageArgs) ([]*planResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Provider) string { return v.Addr })
+
+	return loadPlans(ctx, r.db, "prov_addr", r.v.Addr, keys, withStatus(bson.M{}, args.Status), args.Limit, args.Skip)
+}
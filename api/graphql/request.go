@@ -0,0 +1,22 @@
+package graphql
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type RequestPostGraphQL struct {
+	Body struct {
+		Query         string                 `json:"query" binding:"required,max=8192"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+}
+
+func NewRequestPostGraphQL(c *gin.Context) (req *RequestPostGraphQL, err error) {
+	req = &RequestPostGraphQL{}
+	if err = c.ShouldBindJSON(&req.Body); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,227 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+)
+
+const (
+	maxComplexity = 2500
+	maxDepth      = 8
+	maxLimit      = 100
+)
+
+type pageArgs struct {
+	Limit int32
+	Skip  int32
+}
+
+type statusPageArgs struct {
+	Status *string
+	Limit  int32
+	Skip   int32
+}
+
+// chargePage validates the page arguments of a list field and charges the
+// request for the most documents the list can read for n parents, the skipped
+// ones included since the database reads them as well.
+func chargePage(ctx context.Context, limit, skip int32, n int) error {
+	if limit < 1 || limit > maxLimit {
+		return fmt.Errorf("limit must be between 1 and %d", maxLimit)
+	}
+	if skip < 0 {
+		return fmt.Errorf("skip must not be negative")
+	}
+
+	return complexityFrom(ctx).charge((int(skip) + int(limit)) * n)
+}
+
+// findOptions returns the options of a list field, charging the request for
+// the most documents the list can read.
+func findOptions(ctx context.Context, sort bson.D, limit, skip int32) (*options.FindOptions, error) {
+	if err := chargePage(ctx, limit, skip, 1); err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().
+		SetSkip(int64(skip)).
+		SetLimit(int64(limit))
+	if sort != nil {
+		opts.SetSort(sort)
+	}
+
+	return opts, nil
+}
+
+func withStatus(filter bson.M, status *string) bson.M {
+	if status != nil {
+		filter["status"] = *status
+	}
+
+	return filter
+}
+
+// resolver is the root of the queries. It holds only the database, the state
+// of a request lives in its context.
+type resolver struct {
+	db *mongo.Database
+}
+
+func (r *resolver) Account(ctx context.Context, args struct{ Addr string }) (*accountResolver, error) {
+	item, ok, err := loadersFrom(ctx).accounts.Load(ctx, args.Addr)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newAccountResolver(r.db, item, nil), nil
+}
+
+func (r *resolver) Block(ctx context.Context, args struct{ Height Long }) (*blockResolver, error) {
+	item, ok, err := loadersFrom(ctx).blocks.Load(ctx, int64(args.Height))
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newBlockResolver(r.db, item, nil), nil
+}
+
+func (r *resolver) Blocks(ctx context.Context, args pageArgs) ([]*blockResolver, error) {
+	opts, err := findOptions(ctx, bson.D{{Key: "height", Value: -1}}, args.Limit, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := database.BlockFind(ctx, r.db, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBlockResolvers(r.db, items), nil
+}
+
+func (r *resolver) Deposit(ctx context.Context, args struct{ Addr string }) (*depositResolver, error) {
+	item, ok, err := loadersFrom(ctx).deposits.Load(ctx, args.Addr)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newDepositResolver(r.db, item, nil), nil
+}
+
+func (r *resolver) Deposits(ctx context.Context, args pageArgs) ([]*depositResolver, error) {
+	opts, err := findOptions(ctx, nil, args.Limit, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := database.DepositFind(ctx, r.db, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDepositResolvers(r.db, items), nil
+}
+
+func (r *resolver) Node(ctx context.Context, args struct{ Addr string }) (*nodeResolver, error) {
+	item, ok, err := loadersFrom(ctx).nodes.Load(ctx, args.Addr)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newNodeResolver(r.db, item, nil), nil
+}
+
+func (r *resolver) Nodes(ctx context.Context, args statusPageArgs) ([]*nodeResolver, error) {
+	opts, err := findOptions(ctx, nil, args.Limit, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := database.NodeFind(ctx, r.db, withStatus(bson.M{}, args.Status), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newNodeResolvers(r.db, items), nil
+}
+
+func (r *resolver) Plan(ctx context.Context, args struct{ ID Long }) (*planResolver, error) {
+	item, ok, err := loadersFrom(ctx).plans.Load(ctx, uint64(args.ID))
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newPlanResolver(r.db, item, nil), nil
+}
+
+func (r *resolver) Plans(ctx context.Context, args statusPageArgs) ([]*planResolver, error) {
+	return findPlans(ctx, r.db, withStatus(bson.M{}, args.Status), args.Limit, args.Skip)
+}
+
+func (r *resolver) Provider(ctx context.Context, args struct{ Addr string }) (*providerResolver, error) {
+	item, ok, err := loadersFrom(ctx).providers.Load(ctx, args.Addr)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newProviderResolver(r.db, item, nil), nil
+}
+
+func (r *resolver) Providers(ctx context.Context, args statusPageArgs) ([]*providerResolver, error) {
+	opts, err := findOptions(ctx, nil, args.Limit, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := database.ProviderFind(ctx, r.db, withStatus(bson.M{}, args.Status), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newProviderResolvers(r.db, items), nil
+}
+
+func (r *resolver) Session(ctx context.Context, args struct{ ID Long }) (*sessionResolver, error) {
+	item, ok, err := loadersFrom(ctx).sessions.Load(ctx, uint64(args.ID))
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newSessionResolver(r.db, item, nil), nil
+}
+
+func (r *resolver) Sessions(ctx context.Context, args statusPageArgs) ([]*sessionResolver, error) {
+	return findSessions(ctx, r.db, withStatus(bson.M{}, args.Status), args.Limit, args.Skip)
+}
+
+func (r *resolver) Subscription(ctx context.Context, args struct{ ID Long }) (*subscriptionResolver, error) {
+	item, ok, err := loadersFrom(ctx).subscriptions.Load(ctx, uint64(args.ID))
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newSubscriptionResolver(r.db, item, nil), nil
+}
+
+func (r *resolver) Subscriptions(ctx context.Context, args statusPageArgs) ([]*subscriptionResolver, error) {
+	return findSubscriptions(ctx, r.db, withStatus(bson.M{}, args.Status), args.Limit, args.Skip)
+}
+
+func (r *resolver) Tx(ctx context.Context, args struct{ Hash string }) (*txResolver, error) {
+	item, ok, err := loadersFrom(ctx).txs.Load(ctx, args.Hash)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newTxResolver(r.db, item, nil), nil
+}
+
+func (r *resolver) Txs(ctx context.Context, args pageArgs) ([]*txResolver, error) {
+	return findTxs(ctx, r.db, bson.M{}, bson.D{{Key: "height", Value: -1}}, args.Limit, args.Skip)
+}
@@ -0,0 +1,10 @@
+package graphql
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func RegisterRoutes(router gin.IRouter, db *mongo.Database) {
+	router.POST("/graphql", HandlerPostGraphQL(db, NewSchema(db)))
+}
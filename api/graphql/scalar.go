@@ -0,0 +1,61 @@
+package graphql
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+// Long is a 64-bit integer, as the Int scalar of GraphQL is 32-bit.
+type Long int64
+
+func (Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+func (l *Long) UnmarshalGraphQL(input interface{}) error {
+	switch v := input.(type) {
+	case int32:
+		*l = Long(v)
+	case int64:
+		*l = Long(v)
+	case float64:
+		if v != math.Trunc(v) || math.Abs(v) > math.MaxInt64 {
+			return fmt.Errorf("invalid Long %v", v)
+		}
+
+		*l = Long(v)
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid Long %q", v)
+		}
+
+		*l = Long(n)
+	default:
+		return fmt.Errorf("wrong type for Long: %T", v)
+	}
+
+	return nil
+}
+
+// JSON is an object which is passed through as is, such as the data of a
+// message.
+type JSON map[string]interface{}
+
+func (JSON) ImplementsGraphQLType(name string) bool { return name == "JSON" }
+
+func (j *JSON) UnmarshalGraphQL(input interface{}) error {
+	v, ok := input.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("wrong type for JSON: %T", input)
+	}
+
+	*j = v
+	return nil
+}
+
+func newTime(v time.Time) graphql.Time {
+	return graphql.Time{Time: v}
+}
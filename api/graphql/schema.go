@@ -0,0 +1,23 @@
+package graphql
+
+import (
+	_ "embed"
+
+	"github.com/graph-gophers/graphql-go"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+//go:embed schema.graphql
+var schemaString string
+
+// NewSchema returns the schema of the explorer models. The depth of a query is
+// limited by the schema, the number of documents it reads by the complexity
+// budget of its request.
+func NewSchema(db *mongo.Database) *graphql.Schema {
+	return graphql.MustParseSchema(
+		schemaString,
+		&resolver{db: db},
+		graphql.UseFieldResolvers(),
+		graphql.MaxDepth(maxDepth),
+	)
+}
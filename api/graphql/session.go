@@ -0,0 +1,133 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+type sessionResolver struct {
+	db    *mongo.Database
+	v     *models.Session
+	batch []*models.Session
+}
+
+func newSessionResolver(db *mongo.Database, v *models.Session, batch []*models.Session) *sessionResolver {
+	if batch == nil {
+		batch = []*models.Session{v}
+	}
+
+	return &sessionResolver{
+		db:    db,
+		v:     v,
+		batch: batch,
+	}
+}
+
+func newSessionResolvers(db *mongo.Database, items []*models.Session) []*sessionResolver {
+	resolvers := make([]*sessionResolver, 0, len(items))
+	for _, item := range items {
+		resolvers = append(resolvers, newSessionResolver(db, item, items))
+	}
+
+	return resolvers
+}
+
+func findSessions(ctx context.Context, db *mongo.Database, filter bson.M, limit, skip int32) ([]*sessionResolver, error) {
+	opts, err := findOptions(ctx, bson.D{{Key: "id", Value: -1}}, limit, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := database.SessionFind(ctx, db, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSessionResolvers(db, items), nil
+}
+
+// loadSessions returns the sessions of a relation field, along with the ones of
+// the parents of its batch.
+func loadSessions[K comparable](ctx context.Context, db *mongo.Database, field string, key K, keys []K, filter bson.M, limit, skip int32) ([]*sessionResolver, error) {
+	items, err := loadRelation[K, *models.Session](ctx, db, database.SessionCollectionName, field, key, keys, filter, bson.D{{Key: "id", Value: -1}}, limit, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSessionResolvers(db, items), nil
+}
+
+func (r *sessionResolver) ID() Long                      { return Long(r.v.ID) }
+func (r *sessionResolver) SubscriptionID() Long          { return Long(r.v.SubscriptionID) }
+func (r *sessionResolver) AccAddr() string               { return r.v.AccAddr }
+func (r *sessionResolver) NodeAddr() string              { return r.v.NodeAddr }
+func (r *sessionResolver) Bandwidth() *types.Bandwidth   { return r.v.Bandwidth }
+func (r *sessionResolver) Duration() Long                { return Long(r.v.Duration) }
+func (r *sessionResolver) StartHeight() Long             { return Long(r.v.StartHeight) }
+func (r *sessionResolver) StartTimestamp() graphql.Time  { return newTime(r.v.StartTimestamp) }
+func (r *sessionResolver) StartTxHash() string           { return r.v.StartTxHash }
+func (r *sessionResolver) EndHeight() Long               { return Long(r.v.EndHeight) }
+func (r *sessionResolver) EndTimestamp() graphql.Time    { return newTime(r.v.EndTimestamp) }
+func (r *sessionResolver) EndTxHash() string             { return r.v.EndTxHash }
+func (r *sessionResolver) Payment() *types.Coin          { return r.v.Payment }
+func (r *sessionResolver) StakingReward() *types.Coin    { return r.v.StakingReward }
+func (r *sessionResolver) Rating() Long                  { return Long(r.v.Rating) }
+func (r *sessionResolver) Status() string                { return r.v.Status }
+func (r *sessionResolver) StatusHeight() Long            { return Long(r.v.StatusHeight) }
+func (r *sessionResolver) StatusTimestamp() graphql.Time { return newTime(r.v.StatusTimestamp) }
+func (r *sessionResolver) StatusTxHash() string          { return r.v.StatusTxHash }
+
+func (r *sessionResolver) Account(ctx context.Context) (*accountResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Session) string { return v.AccAddr })
+
+	item, batch, ok, err := loadersFrom(ctx).accounts.LoadBatch(ctx, r.v.AccAddr, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newAccountResolver(r.db, item, batch), nil
+}
+
+func (r *sessionResolver) Node(ctx context.Context) (*nodeResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Session) string { return v.NodeAddr })
+
+	item, batch, ok, err := loadersFrom(ctx).nodes.LoadBatch(ctx, r.v.NodeAddr, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newNodeResolver(r.db, item, batch), nil
+}
+
+func (r *sessionResolver) Subscription(ctx context.Context) (*subscriptionResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Session) uint64 { return v.SubscriptionID })
+
+	item, batch, ok, err := loadersFrom(ctx).subscriptions.LoadBatch(ctx, r.v.SubscriptionID, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newSubscriptionResolver(r.db, item, batch), nil
+}
+
+func (r *sessionResolver) Events(ctx context.Context, args pageArgs) ([]*eventResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Session) uint64 { return v.ID })
+
+	filter := bson.M{
+		"type": bson.M{
+			"$in": bson.A{
+				types.EventTypeSessionUpdateDetails,
+				types.EventTypeSessionUpdateStatus,
+			},
+		},
+	}
+
+	return loadEvents(ctx, r.db, "session_id", r.v.ID, keys, filter, bson.D{{Key: "timestamp", Value: -1}}, args.Limit, args.Skip)
+}
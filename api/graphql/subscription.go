@@ -0,0 +1,142 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+type subscriptionResolver struct {
+	db    *mongo.Database
+	v     *models.Subscription
+	batch []*models.Subscription
+}
+
+func newSubscriptionResolver(db *mongo.Database, v *models.Subscription, batch []*models.Subscription) *subscriptionResolver {
+	if batch == nil {
+		batch = []*models.Subscription{v}
+	}
+
+	return &subscriptionResolver{
+		db:    db,
+		v:     v,
+		batch: batch,
+	}
+}
+
+func newSubscriptionResolvers(db *mongo.Database, items []*models.Subscription) []*subscriptionResolver {
+	resolvers := make([]*subscriptionResolver, 0, len(items))
+	for _, item := range items {
+		resolvers = append(resolvers, newSubscriptionResolver(db, item, items))
+	}
+
+	return resolvers
+}
+
+func findSubscriptions(ctx context.Context, db *mongo.Database, filter bson.M, limit, skip int32) ([]*subscriptionResolver, error) {
+	opts, err := findOptions(ctx, bson.D{{Key: "id", Value: -1}}, limit, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := database.SubscriptionFind(ctx, db, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSubscriptionResolvers(db, items), nil
+}
+
+// loadSubscriptions returns the subscriptions of a relation field, along with
+// the ones of the parents of its batch.
+func loadSubscriptions[K comparable](ctx context.Context, db *mongo.Database, field string, key K, keys []K, filter bson.M, limit, skip int32) ([]*subscriptionResolver, error) {
+	items, err := loadRelation[K, *models.Subscription](ctx, db, database.SubscriptionCollectionName, field, key, keys, filter, bson.D{{Key: "id", Value: -1}}, limit, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSubscriptionResolvers(db, items), nil
+}
+
+func (r *subscriptionResolver) ID() Long                      { return Long(r.v.ID) }
+func (r *subscriptionResolver) AccAddr() string               { return r.v.AccAddr }
+func (r *subscriptionResolver) InactiveAt() graphql.Time      { return newTime(r.v.InactiveAt) }
+func (r *subscriptionResolver) Price() *types.Coin            { return r.v.Price }
+func (r *subscriptionResolver) NodeAddr() string              { return r.v.NodeAddr }
+func (r *subscriptionResolver) Gigabytes() Long               { return Long(r.v.Gigabytes) }
+func (r *subscriptionResolver) Hours() Long                   { return Long(r.v.Hours) }
+func (r *subscriptionResolver) Deposit() *types.Coin          { return r.v.Deposit }
+func (r *subscriptionResolver) Refund() *types.Coin           { return r.v.Refund }
+func (r *subscriptionResolver) PlanID() Long                  { return Long(r.v.PlanID) }
+func (r *subscriptionResolver) Payment() *types.Coin          { return r.v.Payment }
+func (r *subscriptionResolver) StakingReward() *types.Coin    { return r.v.StakingReward }
+func (r *subscriptionResolver) StartHeight() Long             { return Long(r.v.StartHeight) }
+func (r *subscriptionResolver) StartTimestamp() graphql.Time  { return newTime(r.v.StartTimestamp) }
+func (r *subscriptionResolver) StartTxHash() string           { return r.v.StartTxHash }
+func (r *subscriptionResolver) EndHeight() Long               { return Long(r.v.EndHeight) }
+func (r *subscriptionResolver) EndTimestamp() graphql.Time    { return newTime(r.v.EndTimestamp) }
+func (r *subscriptionResolver) EndTxHash() string             { return r.v.EndTxHash }
+func (r *subscriptionResolver) Status() string                { return r.v.Status }
+func (r *subscriptionResolver) StatusHeight() Long            { return Long(r.v.StatusHeight) }
+func (r *subscriptionResolver) StatusTimestamp() graphql.Time { return newTime(r.v.StatusTimestamp) }
+func (r *subscriptionResolver) StatusTxHash() string          { return r.v.StatusTxHash }
+
+func (r *subscriptionResolver) Account(ctx context.Context) (*accountResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Subscription) string { return v.AccAddr })
+
+	item, batch, ok, err := loadersFrom(ctx).accounts.LoadBatch(ctx, r.v.AccAddr, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newAccountResolver(r.db, item, batch), nil
+}
+
+func (r *subscriptionResolver) Node(ctx context.Context) (*nodeResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Subscription) string { return v.NodeAddr })
+
+	item, batch, ok, err := loadersFrom(ctx).nodes.LoadBatch(ctx, r.v.NodeAddr, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newNodeResolver(r.db, item, batch), nil
+}
+
+func (r *subscriptionResolver) Plan(ctx context.Context) (*planResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Subscription) uint64 { return v.PlanID })
+
+	item, batch, ok, err := loadersFrom(ctx).plans.LoadBatch(ctx, r.v.PlanID, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newPlanResolver(r.db, item, batch), nil
+}
+
+func (r *subscriptionResolver) Events(ctx context.Context, args pageArgs) ([]*eventResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Subscription) uint64 { return v.ID })
+
+	filter := bson.M{
+		"type": bson.M{
+			"$in": bson.A{
+				types.EventTypeSubscriptionUpdateDetails,
+				types.EventTypeSubscriptionUpdateStatus,
+			},
+		},
+	}
+
+	return loadEvents(ctx, r.db, "subscription_id", r.v.ID, keys, filter, bson.D{{Key: "timestamp", Value: -1}}, args.Limit, args.Skip)
+}
+
+func (r *subscriptionResolver) Sessions(ctx context.Context, args statusPageArgs) ([]*sessionResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Subscription) uint64 { return v.ID })
+
+	return loadSessions(ctx, r.db, "subscription_id", r.v.ID, keys, withStatus(bson.M{}, args.Status), args.Limit, args.Skip)
+}
@@ -0,0 +1,149 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+type messageResolver struct {
+	v *models.Message
+}
+
+func (r *messageResolver) Type() string { return r.v.Type }
+func (r *messageResolver) Data() *JSON {
+	if r.v.Data == nil {
+		return nil
+	}
+
+	v := JSON(r.v.Data)
+	return &v
+}
+
+type txResolver struct {
+	db    *mongo.Database
+	v     *models.Tx
+	batch []*models.Tx
+}
+
+func newTxResolver(db *mongo.Database, v *models.Tx, batch []*models.Tx) *txResolver {
+	if batch == nil {
+		batch = []*models.Tx{v}
+	}
+
+	return &txResolver{
+		db:    db,
+		v:     v,
+		batch: batch,
+	}
+}
+
+func newTxResolvers(db *mongo.Database, items []*models.Tx) []*txResolver {
+	resolvers := make([]*txResolver, 0, len(items))
+	for _, item := range items {
+		resolvers = append(resolvers, newTxResolver(db, item, items))
+	}
+
+	return resolvers
+}
+
+func findTxs(ctx context.Context, db *mongo.Database, filter bson.M, sort bson.D, limit, skip int32) ([]*txResolver, error) {
+	opts, err := findOptions(ctx, sort, limit, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := database.TxFind(ctx, db, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTxResolvers(db, items), nil
+}
+
+// loadTxs returns the transactions of a relation field, along with the ones of
+// the parents of its batch.
+func loadTxs[K comparable](ctx context.Context, db *mongo.Database, field string, key K, keys []K, filter bson.M, sort bson.D, limit, skip int32) ([]*txResolver, error) {
+	items, err := loadRelation[K, *models.Tx](ctx, db, database.TxCollectionName, field, key, keys, filter, sort, limit, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTxResolvers(db, items), nil
+}
+
+func (r *txResolver) Hash() string            { return r.v.Hash }
+func (r *txResolver) Height() Long            { return Long(r.v.Height) }
+func (r *txResolver) Index() int32            { return int32(r.v.Index) }
+func (r *txResolver) Timestamp() graphql.Time { return newTime(r.v.Timestamp) }
+func (r *txResolver) Fee() types.Coins        { return r.v.Fee }
+func (r *txResolver) GasLimit() Long          { return Long(r.v.GasLimit) }
+func (r *txResolver) Granter() string         { return r.v.Granter }
+func (r *txResolver) Memo() string            { return r.v.Memo }
+func (r *txResolver) MsgTypes() []string      { return r.v.MsgTypes }
+func (r *txResolver) Payer() string           { return r.v.Payer }
+func (r *txResolver) Signers() []string       { return r.v.Signers }
+
+func (r *txResolver) Code() int32 {
+	if r.v.Result == nil {
+		return 0
+	}
+
+	return int32(r.v.Result.Code)
+}
+
+func (r *txResolver) Codespace() string {
+	if r.v.Result == nil {
+		return ""
+	}
+
+	return r.v.Result.Codespace
+}
+
+func (r *txResolver) GasUsed() Long {
+	if r.v.Result == nil {
+		return 0
+	}
+
+	return Long(r.v.Result.GasUsed)
+}
+
+func (r *txResolver) GasWanted() Long {
+	if r.v.Result == nil {
+		return 0
+	}
+
+	return Long(r.v.Result.GasWanted)
+}
+
+func (r *txResolver) Messages() []*messageResolver {
+	items := make([]*messageResolver, 0, len(r.v.Messages))
+	for _, item := range r.v.Messages {
+		items = append(items, &messageResolver{v: item})
+	}
+
+	return items
+}
+
+func (r *txResolver) Block(ctx context.Context) (*blockResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Tx) int64 { return v.Height })
+
+	item, batch, ok, err := loadersFrom(ctx).blocks.LoadBatch(ctx, r.v.Height, keys)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	return newBlockResolver(r.db, item, batch), nil
+}
+
+func (r *txResolver) Events(ctx context.Context, args pageArgs) ([]*eventResolver, error) {
+	keys := batchKeys(r.batch, func(v *models.Tx) string { return v.Hash })
+
+	return loadEvents(ctx, r.db, "tx_hash", r.v.Hash, keys, nil, bson.D{{Key: "_id", Value: 1}}, args.Limit, args.Skip)
+}
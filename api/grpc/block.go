@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	blockapi "github.com/sentinel-official/explorer/api/block"
+	"github.com/sentinel-official/explorer/api/grpc/pb"
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+var blockProjection = bson.M{
+	"height":           1,
+	"time":             1,
+	"proposer_address": 1,
+	"num_txs":          1,
+	"duration":         1,
+}
+
+func newBlock(v *models.Block) *pb.Block {
+	return &pb.Block{
+		Height:          v.Height,
+		Time:            newTimestamp(v.Time),
+		ProposerAddress: v.ProposerAddress,
+		NumTxs:          int64(v.NumTxs),
+		Duration:        v.Duration,
+	}
+}
+
+type blockServer struct {
+	pb.UnimplementedBlockServiceServer
+	db *mongo.Database
+}
+
+func (s *blockServer) QueryBlocks(ctx context.Context, req *pb.QueryBlocksRequest) (*pb.QueryBlocksResponse, error) {
+	p, err := parsePageRequest(blockapi.BlockFields, req)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{}
+	setHeightRange(filter, req.FromHeight, req.ToHeight)
+
+	utils.MergeFilter(filter, p.Filter)
+
+	items, page, err := database.BlockFindPage(ctx, s.db, filter, p.Cursor, p.findOptions(req, blockProjection))
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := &pb.QueryBlocksResponse{
+		Blocks:     make([]*pb.Block, 0, len(items)),
+		Pagination: newPagination(page),
+	}
+	for _, item := range items {
+		res.Blocks = append(res.Blocks, newBlock(item))
+	}
+
+	return res, nil
+}
+
+func (s *blockServer) QueryBlock(ctx context.Context, req *pb.QueryBlockRequest) (*pb.QueryBlockResponse, error) {
+	filter := bson.M{
+		"height": req.Height,
+	}
+	opts := options.FindOne().
+		SetProjection(blockProjection)
+
+	item, err := database.BlockFindOne(ctx, s.db, filter, opts)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if item == nil {
+		return nil, notFoundError("block")
+	}
+
+	return &pb.QueryBlockResponse{Block: newBlock(item)}, nil
+}
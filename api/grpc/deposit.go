@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	depositapi "github.com/sentinel-official/explorer/api/deposit"
+	"github.com/sentinel-official/explorer/api/grpc/pb"
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+func newDeposit(v *models.Deposit) *pb.Deposit {
+	return &pb.Deposit{
+		Addr:      v.Addr,
+		Coins:     newCoins(v.Coins),
+		Height:    v.Height,
+		Timestamp: newTimestamp(v.Timestamp),
+		TxHash:    v.TxHash,
+	}
+}
+
+type depositServer struct {
+	pb.UnimplementedDepositServiceServer
+	db *mongo.Database
+}
+
+func (s *depositServer) QueryDeposits(ctx context.Context, req *pb.QueryDepositsRequest) (*pb.QueryDepositsResponse, error) {
+	p, err := parsePageRequest(depositapi.DepositFields, req)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{}
+	utils.MergeFilter(filter, p.Filter)
+
+	items, page, err := database.DepositFindPage(ctx, s.db, filter, p.Cursor, p.findOptions(req, bson.M{}))
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := &pb.QueryDepositsResponse{
+		Deposits:   make([]*pb.Deposit, 0, len(items)),
+		Pagination: newPagination(page),
+	}
+	for _, item := range items {
+		res.Deposits = append(res.Deposits, newDeposit(item))
+	}
+
+	return res, nil
+}
+
+func (s *depositServer) QueryDeposit(ctx context.Context, req *pb.QueryDepositRequest) (*pb.QueryDepositResponse, error) {
+	filter := bson.M{
+		"addr": req.AccAddr,
+	}
+
+	item, err := database.DepositFindOne(ctx, s.db, filter)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if item == nil {
+		return nil, notFoundError("deposit")
+	}
+
+	return &pb.QueryDepositResponse{Deposit: newDeposit(item)}, nil
+}
@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/api/grpc/pb"
+	nodeapi "github.com/sentinel-official/explorer/api/node"
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+var nodeProjection = bson.M{
+	"_id":            0,
+	"addr":           1,
+	"handshake_dns":  1,
+	"internet_speed": 1,
+	"location":       1,
+	"moniker":        1,
+	"type":           1,
+	"version":        1,
+}
+
+func newNode(v *models.Node) *pb.Node {
+	return &pb.Node{
+		Addr: v.Addr,
+		HandshakeDns: &pb.HandshakeDNS{
+			Enable: v.HandshakeDNS.Enable,
+			Peers:  v.HandshakeDNS.Peers,
+		},
+		InternetSpeed: newBandwidth(&v.InternetSpeed),
+		Location: &pb.Location{
+			City:      v.Location.City,
+			Country:   v.Location.Country,
+			Latitude:  v.Location.Latitude,
+			Longitude: v.Location.Longitude,
+		},
+		Moniker: v.Moniker,
+		Type:    v.Type,
+		Version: v.Version,
+	}
+}
+
+type nodeServer struct {
+	pb.UnimplementedNodeServiceServer
+	db *mongo.Database
+}
+
+func (s *nodeServer) QueryNodes(ctx context.Context, req *pb.QueryNodesRequest) (*pb.QueryNodesResponse, error) {
+	if err := validateStatus(req.Status, "active", "inactive"); err != nil {
+		return nil, err
+	}
+
+	p, err := parsePageRequest(nodeapi.NodeFields, req)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{}
+	if req.Status != "" {
+		filter["status"] = req.Status
+	}
+
+	utils.MergeFilter(filter, p.Filter)
+
+	items, page, err := database.NodeFindPage(ctx, s.db, filter, p.Cursor, p.findOptions(req, nodeProjection))
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := &pb.QueryNodesResponse{
+		Nodes:      make([]*pb.Node, 0, len(items)),
+		Pagination: newPagination(page),
+	}
+	for _, item := range items {
+		res.Nodes = append(res.Nodes, newNode(item))
+	}
+
+	return res, nil
+}
+
+func (s *nodeServer) QueryNode(ctx context.Context, req *pb.QueryNodeRequest) (*pb.QueryNodeResponse, error) {
+	filter := bson.M{
+		"addr": req.NodeAddr,
+	}
+	opts := options.FindOne().
+		SetProjection(nodeProjection)
+
+	item, err := database.NodeFindOne(ctx, s.db, filter, opts)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if item == nil {
+		return nil, notFoundError("node")
+	}
+
+	return &pb.QueryNodeResponse{Node: newNode(item)}, nil
+}
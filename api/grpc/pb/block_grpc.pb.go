@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: sentinel/explorer/v1/block.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	BlockService_QueryBlocks_FullMethodName = "/sentinel.explorer.v1.BlockService/QueryBlocks"
+	BlockService_QueryBlock_FullMethodName  = "/sentinel.explorer.v1.BlockService/QueryBlock"
+)
+
+// BlockServiceClient is the client API for BlockService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BlockServiceClient interface {
+	QueryBlocks(ctx context.Context, in *QueryBlocksRequest, opts ...grpc.CallOption) (*QueryBlocksResponse, error)
+	QueryBlock(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error)
+}
+
+type blockServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlockServiceClient(cc grpc.ClientConnInterface) BlockServiceClient {
+	return &blockServiceClient{cc}
+}
+
+func (c *blockServiceClient) QueryBlocks(ctx context.Context, in *QueryBlocksRequest, opts ...grpc.CallOption) (*QueryBlocksResponse, error) {
+	out := new(QueryBlocksResponse)
+	err := c.cc.Invoke(ctx, BlockService_QueryBlocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockServiceClient) QueryBlock(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error) {
+	out := new(QueryBlockResponse)
+	err := c.cc.Invoke(ctx, BlockService_QueryBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlockServiceServer is the server API for BlockService service.
+// All implementations must embed UnimplementedBlockServiceServer
+// for forward compatibility
+type BlockServiceServer interface {
+	QueryBlocks(context.Context, *QueryBlocksRequest) (*QueryBlocksResponse, error)
+	QueryBlock(context.Context, *QueryBlockRequest) (*QueryBlockResponse, error)
+	mustEmbedUnimplementedBlockServiceServer()
+}
+
+// UnimplementedBlockServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBlockServiceServer struct {
+}
+
+func (UnimplementedBlockServiceServer) QueryBlocks(context.Context, *QueryBlocksRequest) (*QueryBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBlocks not implemented")
+}
+func (UnimplementedBlockServiceServer) QueryBlock(context.Context, *QueryBlockRequest) (*QueryBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBlock not implemented")
+}
+func (UnimplementedBlockServiceServer) mustEmbedUnimplementedBlockServiceServer() {}
+
+// UnsafeBlockServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BlockServiceServer will
+// result in compilation errors.
+type UnsafeBlockServiceServer interface {
+	mustEmbedUnimplementedBlockServiceServer()
+}
+
+func RegisterBlockServiceServer(s grpc.ServiceRegistrar, srv BlockServiceServer) {
+	s.RegisterService(&BlockService_ServiceDesc, srv)
+}
+
+func _BlockService_QueryBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockServiceServer).QueryBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockService_QueryBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockServiceServer).QueryBlocks(ctx, req.(*QueryBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockService_QueryBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockServiceServer).QueryBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockService_QueryBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockServiceServer).QueryBlock(ctx, req.(*QueryBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BlockService_ServiceDesc is the grpc.ServiceDesc for BlockService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BlockService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sentinel.explorer.v1.BlockService",
+	HandlerType: (*BlockServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryBlocks",
+			Handler:    _BlockService_QueryBlocks_Handler,
+		},
+		{
+			MethodName: "QueryBlock",
+			Handler:    _BlockService_QueryBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sentinel/explorer/v1/block.proto",
+}
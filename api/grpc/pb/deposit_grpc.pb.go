@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: sentinel/explorer/v1/deposit.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DepositService_QueryDeposits_FullMethodName = "/sentinel.explorer.v1.DepositService/QueryDeposits"
+	DepositService_QueryDeposit_FullMethodName  = "/sentinel.explorer.v1.DepositService/QueryDeposit"
+)
+
+// DepositServiceClient is the client API for DepositService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DepositServiceClient interface {
+	QueryDeposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	QueryDeposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
+}
+
+type depositServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDepositServiceClient(cc grpc.ClientConnInterface) DepositServiceClient {
+	return &depositServiceClient{cc}
+}
+
+func (c *depositServiceClient) QueryDeposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
+	out := new(QueryDepositsResponse)
+	err := c.cc.Invoke(ctx, DepositService_QueryDeposits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *depositServiceClient) QueryDeposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
+	out := new(QueryDepositResponse)
+	err := c.cc.Invoke(ctx, DepositService_QueryDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DepositServiceServer is the server API for DepositService service.
+// All implementations must embed UnimplementedDepositServiceServer
+// for forward compatibility
+type DepositServiceServer interface {
+	QueryDeposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	QueryDeposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
+	mustEmbedUnimplementedDepositServiceServer()
+}
+
+// UnimplementedDepositServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDepositServiceServer struct {
+}
+
+func (UnimplementedDepositServiceServer) QueryDeposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDeposits not implemented")
+}
+func (UnimplementedDepositServiceServer) QueryDeposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDeposit not implemented")
+}
+func (UnimplementedDepositServiceServer) mustEmbedUnimplementedDepositServiceServer() {}
+
+// UnsafeDepositServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DepositServiceServer will
+// result in compilation errors.
+type UnsafeDepositServiceServer interface {
+	mustEmbedUnimplementedDepositServiceServer()
+}
+
+func RegisterDepositServiceServer(s grpc.ServiceRegistrar, srv DepositServiceServer) {
+	s.RegisterService(&DepositService_ServiceDesc, srv)
+}
+
+func _DepositService_QueryDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepositServiceServer).QueryDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepositService_QueryDeposits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepositServiceServer).QueryDeposits(ctx, req.(*QueryDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DepositService_QueryDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepositServiceServer).QueryDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DepositService_QueryDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepositServiceServer).QueryDeposit(ctx, req.(*QueryDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DepositService_ServiceDesc is the grpc.ServiceDesc for DepositService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DepositService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sentinel.explorer.v1.DepositService",
+	HandlerType: (*DepositServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryDeposits",
+			Handler:    _DepositService_QueryDeposits_Handler,
+		},
+		{
+			MethodName: "QueryDeposit",
+			Handler:    _DepositService_QueryDeposit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sentinel/explorer/v1/deposit.proto",
+}
@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: sentinel/explorer/v1/tx.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	TxService_QueryTxs_FullMethodName = "/sentinel.explorer.v1.TxService/QueryTxs"
+	TxService_QueryTx_FullMethodName  = "/sentinel.explorer.v1.TxService/QueryTx"
+)
+
+// TxServiceClient is the client API for TxService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TxServiceClient interface {
+	QueryTxs(ctx context.Context, in *QueryTxsRequest, opts ...grpc.CallOption) (*QueryTxsResponse, error)
+	QueryTx(ctx context.Context, in *QueryTxRequest, opts ...grpc.CallOption) (*QueryTxResponse, error)
+}
+
+type txServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTxServiceClient(cc grpc.ClientConnInterface) TxServiceClient {
+	return &txServiceClient{cc}
+}
+
+func (c *txServiceClient) QueryTxs(ctx context.Context, in *QueryTxsRequest, opts ...grpc.CallOption) (*QueryTxsResponse, error) {
+	out := new(QueryTxsResponse)
+	err := c.cc.Invoke(ctx, TxService_QueryTxs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txServiceClient) QueryTx(ctx context.Context, in *QueryTxRequest, opts ...grpc.CallOption) (*QueryTxResponse, error) {
+	out := new(QueryTxResponse)
+	err := c.cc.Invoke(ctx, TxService_QueryTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TxServiceServer is the server API for TxService service.
+// All implementations must embed UnimplementedTxServiceServer
+// for forward compatibility
+type TxServiceServer interface {
+	QueryTxs(context.Context, *QueryTxsRequest) (*QueryTxsResponse, error)
+	QueryTx(context.Context, *QueryTxRequest) (*QueryTxResponse, error)
+	mustEmbedUnimplementedTxServiceServer()
+}
+
+// UnimplementedTxServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTxServiceServer struct {
+}
+
+func (UnimplementedTxServiceServer) QueryTxs(context.Context, *QueryTxsRequest) (*QueryTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTxs not implemented")
+}
+func (UnimplementedTxServiceServer) QueryTx(context.Context, *QueryTxRequest) (*QueryTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTx not implemented")
+}
+func (UnimplementedTxServiceServer) mustEmbedUnimplementedTxServiceServer() {}
+
+// UnsafeTxServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TxServiceServer will
+// result in compilation errors.
+type UnsafeTxServiceServer interface {
+	mustEmbedUnimplementedTxServiceServer()
+}
+
+func RegisterTxServiceServer(s grpc.ServiceRegistrar, srv TxServiceServer) {
+	s.RegisterService(&TxService_ServiceDesc, srv)
+}
+
+func _TxService_QueryTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).QueryTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_QueryTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).QueryTxs(ctx, req.(*QueryTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxService_QueryTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServiceServer).QueryTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TxService_QueryTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServiceServer).QueryTx(ctx, req.(*QueryTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TxService_ServiceDesc is the grpc.ServiceDesc for TxService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TxService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sentinel.explorer.v1.TxService",
+	HandlerType: (*TxServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryTxs",
+			Handler:    _TxService_QueryTxs_Handler,
+		},
+		{
+			MethodName: "QueryTx",
+			Handler:    _TxService_QueryTx_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sentinel/explorer/v1/tx.proto",
+}
@@ -0,0 +1,115 @@
+package grpc
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	defaultLimit = 25
+	maxLimit     = 100
+)
+
+// pageRequest is implemented by the list requests, which take the same
+// pagination, sort and filter parameters as the list routes.
+type pageRequest interface {
+	GetCursor() string
+	GetSort() string
+	GetSkip() int64
+	GetLimit() int64
+	GetFilter() map[string]string
+}
+
+// page holds the parsed parameters of a list request.
+type page struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+}
+
+// parsePageRequest parses the parameters of a list request against the fields
+// of the list route. As an unset limit can not be told apart from a zero one,
+// a zero limit takes the default of the route.
+func parsePageRequest(fields utils.Fields, req pageRequest) (p *page, err error) {
+	if req.GetSkip() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "skip must not be negative")
+	}
+	if req.GetLimit() < 0 || req.GetLimit() > maxLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d", maxLimit)
+	}
+
+	query := url.Values{}
+	for key, value := range req.GetFilter() {
+		name, op, _ := strings.Cut(key, "[")
+		if op != "" {
+			op = "[" + op
+		}
+
+		query.Set("filter["+name+"]"+op, value)
+	}
+
+	p = &page{}
+	if p.Sort, err = fields.ParseQuerySort(req.GetSort()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if p.Filter, err = fields.ParseQueryFilter(query); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if p.Cursor, err = utils.ParseQueryCursor(p.Sort, req.GetCursor()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return p, nil
+}
+
+func (p *page) findOptions(req pageRequest, projection bson.M) *options.FindOptions {
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	return options.Find().
+		SetProjection(projection).
+		SetSort(p.Sort).
+		SetSkip(req.GetSkip()).
+		SetLimit(limit)
+}
+
+// setHeightRange filters the documents on the heights between from and to,
+// either of which is ignored when zero.
+func setHeightRange(filter bson.M, from, to int64) {
+	height := bson.M{}
+	if from != 0 {
+		height["$gte"] = from
+	}
+	if to != 0 {
+		height["$lte"] = to
+	}
+	if len(height) > 0 {
+		filter["height"] = height
+	}
+}
+
+func validateStatus(v string, values ...string) error {
+	if v != "" && !slices.Contains(values, v) {
+		return status.Errorf(codes.InvalidArgument, "status must be one of %s", strings.Join(values, ", "))
+	}
+
+	return nil
+}
+
+func internalError(err error) error {
+	return status.Error(codes.Internal, err.Error())
+}
+
+func notFoundError(name string) error {
+	return status.Errorf(codes.NotFound, "%s not found", name)
+}
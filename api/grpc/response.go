@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/sentinel-official/explorer/api/grpc/pb"
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/types"
+)
+
+func newPagination(v *database.Page) *pb.Pagination {
+	item := &pb.Pagination{
+		Total: v.Total,
+	}
+	if v.Next != nil {
+		item.NextCursor = v.Next.String()
+	}
+	if v.Prev != nil {
+		item.PrevCursor = v.Prev.String()
+	}
+
+	return item
+}
+
+func newTimestamp(v time.Time) *timestamppb.Timestamp {
+	if v.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(v)
+}
+
+func newCoin(v *types.Coin) *pb.Coin {
+	if v == nil {
+		return nil
+	}
+
+	return &pb.Coin{
+		Denom:  v.Denom,
+		Amount: v.Amount,
+	}
+}
+
+func newCoins(v types.Coins) []*pb.Coin {
+	items := make([]*pb.Coin, 0, len(v))
+	for _, item := range v {
+		items = append(items, newCoin(item))
+	}
+
+	return items
+}
+
+func newBandwidth(v *types.Bandwidth) *pb.Bandwidth {
+	if v == nil {
+		return nil
+	}
+
+	return &pb.Bandwidth{
+		Upload:   v.Upload,
+		Download: v.Download,
+	}
+}
@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+
+	"github.com/sentinel-official/explorer/api/grpc/pb"
+	statisticsapi "github.com/sentinel-official/explorer/api/statistics"
+)
+
+// RegisterServices registers the query services, which are backed by the same
+// collections and filters as the REST routes.
+func RegisterServices(server *grpc.Server, db *mongo.Database, excludeAddrs []string) {
+	pb.RegisterBlockServiceServer(server, &blockServer{db: db})
+	pb.RegisterDepositServiceServer(server, &depositServer{db: db})
+	pb.RegisterNodeServiceServer(server, &nodeServer{db: db})
+	pb.RegisterSessionServiceServer(server, &sessionServer{db: db})
+	pb.RegisterStatisticsServiceServer(server, &statisticsServer{db: db, requestHandlers: statisticsapi.NewRequestHandlers(excludeAddrs)})
+	pb.RegisterSubscriptionServiceServer(server, &subscriptionServer{db: db})
+	pb.RegisterTxServiceServer(server, &txServer{db: db})
+}
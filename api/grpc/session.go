@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/api/grpc/pb"
+	sessionapi "github.com/sentinel-official/explorer/api/session"
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+func newSession(v *models.Session) *pb.Session {
+	return &pb.Session{
+		Id:              v.ID,
+		SubscriptionId:  v.SubscriptionID,
+		AccAddr:         v.AccAddr,
+		NodeAddr:        v.NodeAddr,
+		Bandwidth:       newBandwidth(v.Bandwidth),
+		Duration:        v.Duration,
+		StartHeight:     v.StartHeight,
+		StartTimestamp:  newTimestamp(v.StartTimestamp),
+		StartTxHash:     v.StartTxHash,
+		EndHeight:       v.EndHeight,
+		EndTimestamp:    newTimestamp(v.EndTimestamp),
+		EndTxHash:       v.EndTxHash,
+		Payment:         newCoin(v.Payment),
+		StakingReward:   newCoin(v.StakingReward),
+		Rating:          v.Rating,
+		Status:          v.Status,
+		StatusHeight:    v.StatusHeight,
+		StatusTimestamp: newTimestamp(v.StatusTimestamp),
+		StatusTxHash:    v.StatusTxHash,
+	}
+}
+
+type sessionServer struct {
+	pb.UnimplementedSessionServiceServer
+	db *mongo.Database
+}
+
+func (s *sessionServer) QuerySessions(ctx context.Context, req *pb.QuerySessionsRequest) (*pb.QuerySessionsResponse, error) {
+	if err := validateStatus(req.Status, "active", "inactive_pending", "inactive"); err != nil {
+		return nil, err
+	}
+
+	p, err := parsePageRequest(sessionapi.SessionFields, req)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{}
+	if req.Status != "" {
+		filter["status"] = req.Status
+	}
+	if req.AccAddr != "" {
+		filter["acc_addr"] = req.AccAddr
+	}
+	if req.SubscriptionId != 0 {
+		filter["subscription_id"] = req.SubscriptionId
+	}
+	if req.NodeAddr != "" {
+		filter["node_addr"] = req.NodeAddr
+	}
+
+	utils.MergeFilter(filter, p.Filter)
+
+	items, page, err := database.SessionFindPage(ctx, s.db, filter, p.Cursor, p.findOptions(req, bson.M{}))
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := &pb.QuerySessionsResponse{
+		Sessions:   make([]*pb.Session, 0, len(items)),
+		Pagination: newPagination(page),
+	}
+	for _, item := range items {
+		res.Sessions = append(res.Sessions, newSession(item))
+	}
+
+	return res, nil
+}
+
+func (s *sessionServer) QuerySession(ctx context.Context, req *pb.QuerySessionRequest) (*pb.QuerySessionResponse, error) {
+	filter := bson.M{
+		"id": req.Id,
+	}
+
+	item, err := database.SessionFindOne(ctx, s.db, filter)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if item == nil {
+		return nil, notFoundError("session")
+	}
+
+	return &pb.QuerySessionResponse{Session: newSession(item)}, nil
+}
@@ -0,0 +1,143 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/sentinel-official/explorer/api/grpc/pb"
+	statisticsapi "github.com/sentinel-official/explorer/api/statistics"
+	"github.com/sentinel-official/explorer/types"
+)
+
+type statisticsServer struct {
+	pb.UnimplementedStatisticsServiceServer
+	db              *mongo.Database
+	requestHandlers statisticsapi.RequestHandlers
+}
+
+// QueryStatistics runs the method of the request the same way as the
+// statistics route, so the request is bound from its query parameters to get
+// the same defaults and validation.
+func (s *statisticsServer) QueryStatistics(_ context.Context, req *pb.QueryStatisticsRequest) (*pb.QueryStatisticsResponse, error) {
+	query := url.Values{}
+	query.Set("method", req.Method)
+	if req.Currency != "" {
+		query.Set("currency", req.Currency)
+	}
+	if req.Status != "" {
+		query.Set("status", req.Status)
+	}
+	if req.Timeframe != "" {
+		query.Set("timeframe", req.Timeframe)
+	}
+	if req.FromTimestamp != nil {
+		query.Set("from_timestamp", req.FromTimestamp.AsTime().Format(time.RFC3339Nano))
+	}
+	if req.ToTimestamp != nil {
+		query.Set("to_timestamp", req.ToTimestamp.AsTime().Format(time.RFC3339Nano))
+	}
+	if req.Sort != "" {
+		query.Set("sort", req.Sort)
+	}
+	if req.Skip != 0 {
+		query.Set("skip", strconv.FormatInt(req.Skip, 10))
+	}
+	if req.Limit != 0 {
+		query.Set("limit", strconv.FormatInt(req.Limit, 10))
+	}
+
+	r, err := statisticsapi.NewRequestGetStatisticsFromQuery(query)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	handlerFunc, err := s.requestHandlers.Get(r)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := handlerFunc(s.db, r)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := &pb.QueryStatisticsResponse{
+		Items: make([]*pb.Statistic, 0, len(result)),
+	}
+	for _, item := range result {
+		v, err := newStatistic(item)
+		if err != nil {
+			return nil, internalError(err)
+		}
+
+		res.Items = append(res.Items, v)
+	}
+
+	return res, nil
+}
+
+// newStatistic converts a result item of a statistics method, whose value is a
+// count, an average, a bandwidth or coins depending on the method.
+func newStatistic(m bson.M) (*pb.Statistic, error) {
+	item := &pb.Statistic{}
+	switch v := m["_id"].(type) {
+	case nil:
+	case string:
+		item.Id = v
+	default:
+		return nil, fmt.Errorf("invalid statistic id type %T", v)
+	}
+
+	if v, ok := m["timestamp"].(primitive.DateTime); ok {
+		item.Timestamp = timestamppb.New(v.Time())
+	}
+
+	switch v := m["value"].(type) {
+	case nil:
+	case int32:
+		item.Value = &pb.Statistic_IntValue{IntValue: int64(v)}
+	case int64:
+		item.Value = &pb.Statistic_IntValue{IntValue: v}
+	case float64:
+		item.Value = &pb.Statistic_FloatValue{FloatValue: v}
+	case bson.A:
+		var coins types.Coins
+		if err := decodeStatisticValue(v, &coins); err != nil {
+			return nil, err
+		}
+
+		item.Value = &pb.Statistic_CoinsValue{CoinsValue: &pb.Coins{Items: newCoins(coins)}}
+	case bson.M, bson.D:
+		var bandwidth types.Bandwidth
+		if err := decodeStatisticValue(v, &bandwidth); err != nil {
+			return nil, err
+		}
+
+		item.Value = &pb.Statistic_BandwidthValue{BandwidthValue: newBandwidth(&bandwidth)}
+	default:
+		return nil, fmt.Errorf("invalid statistic value type %T", v)
+	}
+
+	return item, nil
+}
+
+// decodeStatisticValue decodes the value through its BSON encoding, since a
+// BSON value is not a document on its own.
+func decodeStatisticValue(v, out interface{}) error {
+	buf, err := bson.Marshal(bson.M{"value": v})
+	if err != nil {
+		return err
+	}
+
+	return bson.Raw(buf).Lookup("value").Unmarshal(out)
+}
@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestNewStatistic(t *testing.T) {
+	timestamp := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	item, err := newStatistic(bson.M{"_id": nil, "value": int64(7)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Id != "" || item.GetIntValue() != 7 {
+		t.Fatalf("got %v", item)
+	}
+
+	item, err = newStatistic(bson.M{"_id": "udvpn", "value": 1.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Id != "udvpn" || item.GetFloatValue() != 1.5 {
+		t.Fatalf("got %v", item)
+	}
+
+	item, err = newStatistic(bson.M{
+		"timestamp": primitive.NewDateTimeFromTime(timestamp),
+		"value":     bson.A{bson.M{"denom": "udvpn", "amount": "10"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !item.Timestamp.AsTime().Equal(timestamp) {
+		t.Fatalf("got timestamp %v, want %v", item.Timestamp.AsTime(), timestamp)
+	}
+	if coins := item.GetCoinsValue().GetItems(); len(coins) != 1 || coins[0].Denom != "udvpn" || coins[0].Amount != "10" {
+		t.Fatalf("got coins %v", coins)
+	}
+
+	item, err = newStatistic(bson.M{"value": bson.M{"upload": "1", "download": "2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := item.GetBandwidthValue(); v == nil || v.Upload != "1" || v.Download != "2" {
+		t.Fatalf("got bandwidth %v", v)
+	}
+
+	if _, err := newStatistic(bson.M{"value": "invalid"}); err == nil {
+		t.Fatal("expected an error on an invalid value type")
+	}
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/api/grpc/pb"
+	subscriptionapi "github.com/sentinel-official/explorer/api/subscription"
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+func newSubscription(v *models.Subscription) *pb.Subscription {
+	return &pb.Subscription{
+		Id:              v.ID,
+		AccAddr:         v.AccAddr,
+		InactiveAt:      newTimestamp(v.InactiveAt),
+		Price:           newCoin(v.Price),
+		NodeAddr:        v.NodeAddr,
+		Gigabytes:       v.Gigabytes,
+		Hours:           v.Hours,
+		Deposit:         newCoin(v.Deposit),
+		Refund:          newCoin(v.Refund),
+		PlanId:          v.PlanID,
+		Payment:         newCoin(v.Payment),
+		StakingReward:   newCoin(v.StakingReward),
+		StartHeight:     v.StartHeight,
+		StartTimestamp:  newTimestamp(v.StartTimestamp),
+		StartTxHash:     v.StartTxHash,
+		EndHeight:       v.EndHeight,
+		EndTimestamp:    newTimestamp(v.EndTimestamp),
+		EndTxHash:       v.EndTxHash,
+		Status:          v.Status,
+		StatusHeight:    v.StatusHeight,
+		StatusTimestamp: newTimestamp(v.StatusTimestamp),
+		StatusTxHash:    v.StatusTxHash,
+	}
+}
+
+type subscriptionServer struct {
+	pb.UnimplementedSubscriptionServiceServer
+	db *mongo.Database
+}
+
+func (s *subscriptionServer) QuerySubscriptions(ctx context.Context, req *pb.QuerySubscriptionsRequest) (*pb.QuerySubscriptionsResponse, error) {
+	if err := validateStatus(req.Status, "active", "inactive_pending", "inactive"); err != nil {
+		return nil, err
+	}
+
+	p, err := parsePageRequest(subscriptionapi.SubscriptionFields, req)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{}
+	if req.Status != "" {
+		filter["status"] = req.Status
+	}
+	if req.AccAddr != "" {
+		filter["acc_addr"] = req.AccAddr
+	}
+	if req.PlanId != 0 {
+		filter["plan_id"] = req.PlanId
+	}
+	if req.NodeAddr != "" {
+		filter["node_addr"] = req.NodeAddr
+	}
+
+	utils.MergeFilter(filter, p.Filter)
+
+	items, page, err := database.SubscriptionFindPage(ctx, s.db, filter, p.Cursor, p.findOptions(req, bson.M{}))
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := &pb.QuerySubscriptionsResponse{
+		Subscriptions: make([]*pb.Subscription, 0, len(items)),
+		Pagination:    newPagination(page),
+	}
+	for _, item := range items {
+		res.Subscriptions = append(res.Subscriptions, newSubscription(item))
+	}
+
+	return res, nil
+}
+
+func (s *subscriptionServer) QuerySubscription(ctx context.Context, req *pb.QuerySubscriptionRequest) (*pb.QuerySubscriptionResponse, error) {
+	filter := bson.M{
+		"id": req.Id,
+	}
+
+	item, err := database.SubscriptionFindOne(ctx, s.db, filter)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if item == nil {
+		return nil, notFoundError("subscription")
+	}
+
+	return &pb.QuerySubscriptionResponse{Subscription: newSubscription(item)}, nil
+}
@@ -0,0 +1,120 @@
+package grpc
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/api/grpc/pb"
+	txapi "github.com/sentinel-official/explorer/api/tx"
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+var txProjection = bson.M{
+	"hash":                 1,
+	"height":               1,
+	"index":                1,
+	"signer_infos.address": 1,
+	"fee":                  1,
+	"gas_limit":            1,
+	"payer":                1,
+	"memo":                 1,
+	"msg_types":            1,
+	"result.code":          1,
+	"result.codespace":     1,
+	"result.gas_wanted":    1,
+	"result.gas_used":      1,
+}
+
+func newTx(v *models.Tx) *pb.Tx {
+	item := &pb.Tx{
+		Hash:        v.Hash,
+		Height:      v.Height,
+		Index:       int64(v.Index),
+		SignerInfos: make([]*pb.TxSignerInfo, 0, len(v.SignerInfos)),
+		Fee:         newCoins(v.Fee),
+		GasLimit:    v.GasLimit,
+		Payer:       v.Payer,
+		Memo:        v.Memo,
+		MsgTypes:    v.MsgTypes,
+	}
+	for _, info := range v.SignerInfos {
+		item.SignerInfos = append(item.SignerInfos, &pb.TxSignerInfo{Address: info.Address})
+	}
+	if v.Result != nil {
+		item.Result = &pb.TxResult{
+			Code:      v.Result.Code,
+			Codespace: v.Result.Codespace,
+			GasWanted: v.Result.GasWanted,
+			GasUsed:   v.Result.GasUsed,
+		}
+	}
+
+	return item
+}
+
+type txServer struct {
+	pb.UnimplementedTxServiceServer
+	db *mongo.Database
+}
+
+func (s *txServer) QueryTxs(ctx context.Context, req *pb.QueryTxsRequest) (*pb.QueryTxsResponse, error) {
+	p, err := parsePageRequest(txapi.TxFields, req)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{}
+	setHeightRange(filter, req.FromHeight, req.ToHeight)
+	if req.Height != 0 {
+		filter["height"] = req.Height
+	}
+	if req.MsgType != "" {
+		filter["msg_types"] = req.MsgType
+	}
+	if req.Signer != "" {
+		filter["signers"] = req.Signer
+	}
+	if req.AccAddr != "" {
+		filter["signers"] = req.AccAddr
+	}
+
+	utils.MergeFilter(filter, p.Filter)
+
+	items, page, err := database.TxFindPage(ctx, s.db, filter, p.Cursor, p.findOptions(req, txProjection))
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := &pb.QueryTxsResponse{
+		Txs:        make([]*pb.Tx, 0, len(items)),
+		Pagination: newPagination(page),
+	}
+	for _, item := range items {
+		res.Txs = append(res.Txs, newTx(item))
+	}
+
+	return res, nil
+}
+
+func (s *txServer) QueryTx(ctx context.Context, req *pb.QueryTxRequest) (*pb.QueryTxResponse, error) {
+	filter := bson.M{
+		"hash": req.Hash,
+	}
+	opts := options.FindOne().
+		SetProjection(txProjection)
+
+	item, err := database.TxFindOne(ctx, s.db, filter, opts)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if item == nil {
+		return nil, notFoundError("tx")
+	}
+
+	return &pb.QueryTxResponse{Tx: newTx(item)}, nil
+}
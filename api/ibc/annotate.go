@@ -0,0 +1,198 @@
+package ibc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+)
+
+// denomTraces caches the denom traces by their hash. A trace never changes
+// once created, so the cached ones are never invalidated.
+type denomTraces struct {
+	db    *mongo.Database
+	mu    sync.RWMutex
+	items map[string]*models.DenomTrace
+}
+
+// Get returns the traces of the hashes, looking up the ones which are not
+// cached yet.
+func (t *denomTraces) Get(ctx context.Context, hashes []string) (map[string]*models.DenomTrace, error) {
+	var (
+		items   = make(map[string]*models.DenomTrace)
+		missing = bson.A{}
+	)
+
+	t.mu.RLock()
+	for _, hash := range hashes {
+		if item, ok := t.items[hash]; ok {
+			items[hash] = item
+		} else {
+			missing = append(missing, hash)
+		}
+	}
+	t.mu.RUnlock()
+
+	if len(missing) == 0 {
+		return items, nil
+	}
+
+	filter := bson.M{
+		"hash": bson.M{
+			"$in": missing,
+		},
+	}
+
+	dTraces, err := database.DenomTraceFind(ctx, t.db, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, item := range dTraces {
+		t.items[item.Hash] = item
+		items[item.Hash] = item
+	}
+
+	return items, nil
+}
+
+// walkCoins calls the function with every object of the value which looks
+// like a coin of an IBC denom, along with the hash of the denom.
+func walkCoins(v interface{}, fn func(m map[string]interface{}, hash string)) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if denom, ok := v["denom"].(string); ok && strings.HasPrefix(denom, "ibc/") {
+			if _, ok := v["amount"]; ok {
+				fn(v, strings.TrimPrefix(denom, "ibc/"))
+			}
+		}
+
+		for _, item := range v {
+			walkCoins(item, fn)
+		}
+	case []interface{}:
+		for _, item := range v {
+			walkCoins(item, fn)
+		}
+	}
+}
+
+func annotate(ctx context.Context, traces *denomTraces, body []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	var hashes []string
+	walkCoins(v, func(_ map[string]interface{}, hash string) {
+		hashes = append(hashes, hash)
+	})
+
+	if len(hashes) == 0 {
+		return body, nil
+	}
+
+	items, err := traces.Get(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	walkCoins(v, func(m map[string]interface{}, hash string) {
+		if item, ok := items[hash]; ok {
+			m["base_denom"] = item.BaseDenom
+			m["path"] = item.Path
+		}
+	})
+
+	return json.Marshal(v)
+}
+
+// bufferedWriter holds the response back until the handler returns, unless
+// the handler streams it by flushing or hijacking the connection, in which
+// case the response is passed through as is.
+type bufferedWriter struct {
+	gin.ResponseWriter
+	buf       bytes.Buffer
+	streaming bool
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	if w.streaming {
+		return w.ResponseWriter.Write(b)
+	}
+
+	return w.buf.Write(b)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	if w.streaming {
+		return w.ResponseWriter.WriteString(s)
+	}
+
+	return w.buf.WriteString(s)
+}
+
+func (w *bufferedWriter) Flush() {
+	if !w.streaming {
+		w.streaming = true
+		_, _ = w.ResponseWriter.Write(w.buf.Bytes())
+		w.buf.Reset()
+	}
+
+	w.ResponseWriter.Flush()
+}
+
+func (w *bufferedWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.streaming = true
+	return w.ResponseWriter.Hijack()
+}
+
+// AnnotateDenoms returns a middleware which adds the base denom and the trace
+// path to every coin of an IBC denom within the responses, so that the
+// handlers do not have to resolve the denoms themselves.
+func AnnotateDenoms(db *mongo.Database) gin.HandlerFunc {
+	traces := &denomTraces{
+		db:    db,
+		items: make(map[string]*models.DenomTrace),
+	}
+
+	return func(c *gin.Context) {
+		w := &bufferedWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+
+		if w.streaming {
+			return
+		}
+
+		body := w.buf.Bytes()
+		if bytes.Contains(body, []byte(`"ibc/`)) {
+			v, err := annotate(c.Request.Context(), traces, body)
+			if err != nil {
+				log.Println("AnnotateDenoms", err)
+			} else {
+				body = v
+			}
+		}
+
+		_, _ = w.ResponseWriter.Write(body)
+	}
+}
@@ -0,0 +1,130 @@
+package ibc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+func HandlerGetIBCTransfers(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetIBCTransfers(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{}
+		if req.URI.AccAddr != "" {
+			filter["$or"] = bson.A{
+				bson.M{"sender": req.URI.AccAddr},
+				bson.M{"receiver": req.URI.AccAddr},
+			}
+		}
+		if req.Query.Channel != "" {
+			filter["$and"] = bson.A{
+				bson.M{
+					"$or": bson.A{
+						bson.M{"src_channel": req.Query.Channel},
+						bson.M{"dst_channel": req.Query.Channel},
+					},
+				},
+			}
+		}
+		if req.Query.Direction != "" {
+			filter["direction"] = req.Query.Direction
+		}
+		if req.Query.Status != "" {
+			filter["status"] = req.Query.Status
+		}
+
+		utils.MergeFilter(filter, req.Filter)
+
+		projection := bson.M{
+			"_id": 0,
+		}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.IBCTransferFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
+
+func HandlerGetDenomTraces(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetDenomTraces(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{}
+		if req.Query.BaseDenom != "" {
+			filter["base_denom"] = req.Query.BaseDenom
+		}
+
+		utils.MergeFilter(filter, req.Filter)
+
+		projection := bson.M{
+			"_id": 0,
+		}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.DenomTraceFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
+
+func HandlerGetDenomTrace(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetDenomTrace(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{
+			"hash": strings.ToUpper(strings.TrimPrefix(req.URI.Hash, "ibc/")),
+		}
+		projection := bson.M{
+			"_id": 0,
+		}
+		opts := options.FindOne().
+			SetProjection(projection)
+
+		item, err := database.DenomTraceFindOne(context.TODO(), db, filter, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(item))
+	}
+}
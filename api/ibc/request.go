@@ -0,0 +1,109 @@
+package ibc
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/utils"
+)
+
+var (
+	denomTraceFields = utils.Fields{
+		"hash": {Type: utils.FieldTypeString, Filter: true},
+	}
+	ibcTransferFields = utils.Fields{
+		"dst_channel": {Type: utils.FieldTypeString, Filter: true},
+		"height":      {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+		"receiver":    {Type: utils.FieldTypeString, Filter: true},
+		"sender":      {Type: utils.FieldTypeString, Filter: true},
+		"sequence":    {Type: utils.FieldTypeInt, Filter: true},
+		"src_channel": {Type: utils.FieldTypeString, Filter: true},
+	}
+)
+
+type RequestGetIBCTransfers struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	URI struct {
+		AccAddr string `uri:"acc_addr"`
+	}
+	Query struct {
+		Channel   string `form:"channel"`
+		Direction string `form:"direction" binding:"omitempty,oneof=incoming outgoing"`
+		Status    string `form:"status" binding:"omitempty,oneof=pending acknowledged received failed timed_out"`
+		Cursor    string `form:"cursor"`
+		Sort      string `form:"sort,default=-height"`
+		Skip      int64  `form:"skip" binding:"gte=0"`
+		Limit     int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetIBCTransfers(c *gin.Context) (req *RequestGetIBCTransfers, err error) {
+	req = &RequestGetIBCTransfers{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = ibcTransferFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = ibcTransferFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetDenomTraces struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	Query struct {
+		BaseDenom string `form:"base_denom"`
+		Cursor    string `form:"cursor"`
+		Skip      int64  `form:"skip" binding:"gte=0"`
+		Limit     int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetDenomTraces(c *gin.Context) (req *RequestGetDenomTraces, err error) {
+	req = &RequestGetDenomTraces{
+		Sort: bson.D{
+			bson.E{Key: "hash", Value: 1},
+		},
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = denomTraceFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetDenomTrace struct {
+	URI struct {
+		Hash string `uri:"hash"`
+	}
+}
+
+func NewRequestGetDenomTrace(c *gin.Context) (req *RequestGetDenomTrace, err error) {
+	req = &RequestGetDenomTrace{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,15 @@
+package ibc
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func RegisterRoutes(router gin.IRouter, db *mongo.Database) {
+	router.GET("/accounts/:acc_addr/ibc-transfers", HandlerGetIBCTransfers(db))
+
+	router.GET("/denom-traces", HandlerGetDenomTraces(db))
+	router.GET("/denom-traces/:hash", HandlerGetDenomTrace(db))
+
+	router.GET("/ibc-transfers", HandlerGetIBCTransfers(db))
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/sentinel-official/explorer/database"
 	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
 )
 
 func HandlerGetNodes(db *mongo.Database) gin.HandlerFunc {
@@ -27,6 +28,8 @@ func HandlerGetNodes(db *mongo.Database) gin.HandlerFunc {
 			filter["status"] = req.Query.Status
 		}
 
+		utils.MergeFilter(filter, req.Filter)
+
 		projection := bson.M{
 			"_id":            0,
 			"addr":           1,
@@ -43,13 +46,13 @@ func HandlerGetNodes(db *mongo.Database) gin.HandlerFunc {
 			SetSkip(req.Query.Skip).
 			SetLimit(req.Query.Limit)
 
-		items, err := database.NodeFind(context.TODO(), db, filter, opts)
+		items, page, err := database.NodeFindPage(context.TODO(), db, filter, req.Cursor, opts)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
 			return
 		}
 
-		c.JSON(http.StatusOK, types.NewResponseResult(items))
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
 	}
 }
 
@@ -104,6 +107,9 @@ func HandlerGetNodeEvents(db *mongo.Database) gin.HandlerFunc {
 			},
 			"node_address": req.URI.NodeAddr,
 		}
+
+		utils.MergeFilter(filter, req.Filter)
+
 		projection := bson.M{}
 		opts := options.Find().
 			SetProjection(projection).
@@ -111,13 +117,13 @@ func HandlerGetNodeEvents(db *mongo.Database) gin.HandlerFunc {
 			SetSkip(req.Query.Skip).
 			SetLimit(req.Query.Limit)
 
-		items, err := database.EventFind(context.TODO(), db, filter, opts)
+		items, page, err := database.EventFindPage(context.TODO(), db, filter, req.Cursor, opts)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
 			return
 		}
 
-		c.JSON(http.StatusOK, types.NewResponseResult(items))
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
 	}
 }
 
@@ -9,11 +9,21 @@ import (
 	"github.com/sentinel-official/explorer/utils"
 )
 
+var NodeFields = utils.Fields{
+	"addr":            {Type: utils.FieldTypeString, Filter: true},
+	"peers":           {Type: utils.FieldTypeInt, Sort: true},
+	"register_height": {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+	"remote_url":      {Type: utils.FieldTypeString, Filter: true},
+}
+
 type RequestGetNodes struct {
-	Sort bson.D
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
 
 	Query struct {
 		Status string `form:"status" binding:"omitempty,oneof=active inactive"`
+		Cursor string `form:"cursor"`
 		Sort   string `form:"sort"`
 		Skip   int64  `form:"skip" binding:"gte=0"`
 		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
@@ -25,14 +35,13 @@ func NewRequestGetNodes(c *gin.Context) (req *RequestGetNodes, err error) {
 	if err = c.ShouldBindQuery(&req.Query); err != nil {
 		return nil, err
 	}
-
-	allowed := []string{
-		"peers",
-		"-peers",
-		"register_height",
-		"-register_height",
+	if req.Sort, err = NodeFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = NodeFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
 	}
-	if req.Sort, err = utils.ParseQuerySort(allowed, req.Query.Sort); err != nil {
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
 		return nil, err
 	}
 
@@ -55,15 +64,18 @@ func NewRequestGetNode(c *gin.Context) (req *RequestGetNode, err error) {
 }
 
 type RequestGetNodeEvents struct {
-	Sort bson.D
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
 
 	URI struct {
 		NodeAddr string `uri:"node_addr"`
 	}
 	Query struct {
-		Sort  string `form:"sort"`
-		Skip  int64  `form:"skip" binding:"gte=0"`
-		Limit int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
 	}
 }
 
@@ -75,12 +87,13 @@ func NewRequestGetNodeEvents(c *gin.Context) (req *RequestGetNodeEvents, err err
 	if err = c.ShouldBindQuery(&req.Query); err != nil {
 		return nil, err
 	}
-
-	allowed := []string{
-		"height",
-		"-height",
+	if req.Sort, err = utils.EventFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = utils.EventFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
 	}
-	if req.Sort, err = utils.ParseQuerySort(allowed, req.Query.Sort); err != nil {
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
 		return nil, err
 	}
 
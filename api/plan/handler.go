@@ -0,0 +1,179 @@
+package plan
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+func HandlerGetPlans(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetPlans(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{}
+		if req.Query.Status != "" {
+			filter["status"] = req.Query.Status
+		}
+
+		utils.MergeFilter(filter, req.Filter)
+
+		projection := bson.M{
+			"_id": 0,
+		}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.PlanFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
+
+func HandlerGetPlan(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetPlan(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{
+			"id": req.URI.ID,
+		}
+		projection := bson.M{
+			"_id": 0,
+		}
+		opts := options.FindOne().
+			SetProjection(projection)
+
+		item, err := database.PlanFindOne(context.TODO(), db, filter, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(item))
+	}
+}
+
+func HandlerGetPlanEvents(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetPlanEvents(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{
+			"type": bson.M{
+				"$in": bson.A{
+					types.EventTypePlanLinkNode,
+					types.EventTypePlanUnlinkNode,
+					types.EventTypePlanUpdateStatus,
+				},
+			},
+			"plan_id": req.URI.ID,
+		}
+
+		utils.MergeFilter(filter, req.Filter)
+
+		projection := bson.M{
+			"_id": 0,
+		}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.EventFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
+
+func HandlerGetPlanNodes(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetPlanNodes(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{
+			"id": req.URI.ID,
+		}
+		projection := bson.M{
+			"_id":        0,
+			"node_addrs": 1,
+		}
+
+		plan, err := database.PlanFindOne(context.TODO(), db, filter, options.FindOne().SetProjection(projection))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+		if plan == nil {
+			c.JSON(http.StatusOK, types.NewResponseResult(nil))
+			return
+		}
+
+		filter = bson.M{
+			"addr": bson.M{
+				"$in": plan.NodeAddrs,
+			},
+		}
+		if req.Query.Status != "" {
+			filter["status"] = req.Query.Status
+		}
+
+		utils.MergeFilter(filter, req.Filter)
+
+		projection = bson.M{
+			"_id":            0,
+			"addr":           1,
+			"handshake_dns":  1,
+			"internet_speed": 1,
+			"location":       1,
+			"moniker":        1,
+			"type":           1,
+			"version":        1,
+		}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.NodeFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
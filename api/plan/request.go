@@ -0,0 +1,136 @@
+package plan
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+
+	nodeapi "github.com/sentinel-official/explorer/api/node"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+var PlanFields = utils.Fields{
+	"create_height": {Type: utils.FieldTypeInt, Sort: true},
+	"id":            {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+}
+
+type RequestGetPlans struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	Query struct {
+		Status string `form:"status" binding:"omitempty,oneof=active inactive"`
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetPlans(c *gin.Context) (req *RequestGetPlans, err error) {
+	req = &RequestGetPlans{}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = PlanFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = PlanFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetPlan struct {
+	URI struct {
+		ID uint64 `uri:"id"`
+	}
+}
+
+func NewRequestGetPlan(c *gin.Context) (req *RequestGetPlan, err error) {
+	req = &RequestGetPlan{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetPlanEvents struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	URI struct {
+		ID uint64 `uri:"id"`
+	}
+	Query struct {
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetPlanEvents(c *gin.Context) (req *RequestGetPlanEvents, err error) {
+	req = &RequestGetPlanEvents{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = utils.EventFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = utils.EventFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetPlanNodes struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	URI struct {
+		ID uint64 `uri:"id"`
+	}
+	Query struct {
+		Status string `form:"status" binding:"omitempty,oneof=active inactive"`
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetPlanNodes(c *gin.Context) (req *RequestGetPlanNodes, err error) {
+	req = &RequestGetPlanNodes{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = nodeapi.NodeFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = nodeapi.NodeFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,13 @@
+package plan
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func RegisterRoutes(router gin.IRouter, db *mongo.Database) {
+	router.GET("/plans", HandlerGetPlans(db))
+	router.GET("/plans/:id", HandlerGetPlan(db))
+	router.GET("/plans/:id/events", HandlerGetPlanEvents(db))
+	router.GET("/plans/:id/nodes", HandlerGetPlanNodes(db))
+}
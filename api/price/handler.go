@@ -0,0 +1,55 @@
+package price
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+func HandlerGetPrices(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetPrices(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{
+			"denom":     req.Query.Denom,
+			"quote":     strings.ToLower(req.Query.Quote),
+			"timeframe": req.Query.Timeframe,
+			"timestamp": bson.M{
+				"$gte": req.Query.FromTimestamp,
+				"$lt":  req.Query.ToTimestamp,
+			},
+		}
+
+		utils.MergeFilter(filter, req.Filter)
+
+		projection := bson.M{
+			"_id": 0,
+		}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.PriceFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
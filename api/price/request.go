@@ -0,0 +1,50 @@
+package price
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/utils"
+)
+
+var priceFields = utils.Fields{
+	"timestamp": {Type: utils.FieldTypeTime, Filter: true, Sort: true},
+}
+
+type RequestGetPrices struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	Query struct {
+		Denom         string    `form:"denom,default=udvpn"`
+		FromTimestamp time.Time `form:"from_timestamp"`
+		Limit         int64     `form:"limit,default=25" binding:"gte=0,lte=100"`
+		Quote         string    `form:"quote,default=usd"`
+		Skip          int64     `form:"skip" binding:"gte=0"`
+		Cursor        string    `form:"cursor"`
+		Sort          string    `form:"sort"`
+		Timeframe     string    `form:"timeframe,default=day" binding:"oneof=hour day"`
+		ToTimestamp   time.Time `form:"to_timestamp,default=9999-12-31T23:59:59Z" binding:"gtfield=FromTimestamp"`
+	}
+}
+
+func NewRequestGetPrices(c *gin.Context) (req *RequestGetPrices, err error) {
+	req = &RequestGetPrices{}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = priceFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = priceFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,10 @@
+package price
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func RegisterRoutes(router gin.IRouter, db *mongo.Database) {
+	router.GET("/prices", HandlerGetPrices(db))
+}
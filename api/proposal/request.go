@@ -0,0 +1,148 @@
+package proposal
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/utils"
+)
+
+var (
+	depositFields = utils.Fields{
+		"depositor": {Type: utils.FieldTypeString, Filter: true},
+		"height":    {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+	}
+	proposalFields = utils.Fields{
+		"id":            {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+		"status_height": {Type: utils.FieldTypeInt, Sort: true},
+		"submit_height": {Type: utils.FieldTypeInt, Sort: true},
+	}
+	voteFields = utils.Fields{
+		"height":      {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+		"proposal_id": {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+		"voter":       {Type: utils.FieldTypeString, Filter: true},
+	}
+)
+
+type RequestGetProposals struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	Query struct {
+		Status string `form:"status"`
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort,default=-id"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetProposals(c *gin.Context) (req *RequestGetProposals, err error) {
+	req = &RequestGetProposals{}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = proposalFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = proposalFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetProposal struct {
+	URI struct {
+		ID uint64 `uri:"id"`
+	}
+}
+
+func NewRequestGetProposal(c *gin.Context) (req *RequestGetProposal, err error) {
+	req = &RequestGetProposal{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetProposalDeposits struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	URI struct {
+		ID uint64 `uri:"id"`
+	}
+	Query struct {
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort,default=-height"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetProposalDeposits(c *gin.Context) (req *RequestGetProposalDeposits, err error) {
+	req = &RequestGetProposalDeposits{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = depositFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = depositFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetVotes struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	URI struct {
+		ID      uint64 `uri:"id"`
+		AccAddr string `uri:"acc_addr"`
+	}
+	Query struct {
+		Option string `form:"option"`
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort,default=-height"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetVotes(c *gin.Context) (req *RequestGetVotes, err error) {
+	req = &RequestGetVotes{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = voteFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = voteFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,15 @@
+package proposal
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func RegisterRoutes(router gin.IRouter, db *mongo.Database) {
+	router.GET("/accounts/:acc_addr/votes", HandlerGetVotes(db))
+
+	router.GET("/proposals", HandlerGetProposals(db))
+	router.GET("/proposals/:id", HandlerGetProposal(db))
+	router.GET("/proposals/:id/deposits", HandlerGetProposalDeposits(db))
+	router.GET("/proposals/:id/votes", HandlerGetVotes(db))
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+
+	planapi "github.com/sentinel-official/explorer/api/plan"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+var providerFields = utils.Fields{
+	"addr":            {Type: utils.FieldTypeString, Filter: true},
+	"register_height": {Type: utils.FieldTypeInt, Sort: true},
+}
+
+type RequestGetProviders struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	Query struct {
+		Status string `form:"status" binding:"omitempty,oneof=active inactive"`
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetProviders(c *gin.Context) (req *RequestGetProviders, err error) {
+	req = &RequestGetProviders{}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = providerFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = providerFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetProvider struct {
+	URI struct {
+		ProvAddr string `uri:"prov_addr"`
+	}
+}
+
+func NewRequestGetProvider(c *gin.Context) (req *RequestGetProvider, err error) {
+	req = &RequestGetProvider{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetProviderEvents struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	URI struct {
+		ProvAddr string `uri:"prov_addr"`
+	}
+	Query struct {
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetProviderEvents(c *gin.Context) (req *RequestGetProviderEvents, err error) {
+	req = &RequestGetProviderEvents{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = utils.EventFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = utils.EventFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetProviderPlans struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
+	URI struct {
+		ProvAddr string `uri:"prov_addr"`
+	}
+	Query struct {
+		Status string `form:"status" binding:"omitempty,oneof=active inactive"`
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetProviderPlans(c *gin.Context) (req *RequestGetProviderPlans, err error) {
+	req = &RequestGetProviderPlans{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = planapi.PlanFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = planapi.PlanFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
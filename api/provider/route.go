@@ -0,0 +1,13 @@
+package provider
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func RegisterRoutes(router gin.IRouter, db *mongo.Database) {
+	router.GET("/providers", HandlerGetProviders(db))
+	router.GET("/providers/:prov_addr", HandlerGetProvider(db))
+	router.GET("/providers/:prov_addr/events", HandlerGetProviderEvents(db))
+	router.GET("/providers/:prov_addr/plans", HandlerGetProviderPlans(db))
+}
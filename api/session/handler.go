@@ -11,6 +11,7 @@ import (
 
 	"github.com/sentinel-official/explorer/database"
 	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
 )
 
 func HandlerGetSessions(db *mongo.Database) gin.HandlerFunc {
@@ -35,19 +36,22 @@ func HandlerGetSessions(db *mongo.Database) gin.HandlerFunc {
 			filter["node_address"] = req.URI.NodeAddr
 		}
 
+		utils.MergeFilter(filter, req.Filter)
+
 		projection := bson.M{}
 		opts := options.Find().
 			SetProjection(projection).
+			SetSort(req.Sort).
 			SetSkip(req.Query.Skip).
 			SetLimit(req.Query.Limit)
 
-		items, err := database.SessionFind(context.TODO(), db, filter, opts)
+		items, page, err := database.SessionFindPage(context.TODO(), db, filter, req.Cursor, opts)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
 			return
 		}
 
-		c.JSON(http.StatusOK, types.NewResponseResult(items))
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
 	}
 }
 
@@ -93,18 +97,22 @@ func HandlerGetSessionEvents(db *mongo.Database) gin.HandlerFunc {
 			},
 			"session_id": req.URI.ID,
 		}
+
+		utils.MergeFilter(filter, req.Filter)
+
 		projection := bson.M{}
 		opts := options.Find().
 			SetProjection(projection).
+			SetSort(req.Sort).
 			SetSkip(req.Query.Skip).
 			SetLimit(req.Query.Limit)
 
-		items, err := database.EventFind(context.TODO(), db, filter, opts)
+		items, page, err := database.EventFindPage(context.TODO(), db, filter, req.Cursor, opts)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
 			return
 		}
 
-		c.JSON(http.StatusOK, types.NewResponseResult(items))
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
 	}
 }
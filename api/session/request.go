@@ -2,9 +2,22 @@ package session
 
 import (
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/utils"
 )
 
+var SessionFields = utils.Fields{
+	"acc_addr":  {Type: utils.FieldTypeString, Filter: true},
+	"id":        {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+	"node_addr": {Type: utils.FieldTypeString, Filter: true},
+}
+
 type RequestGetSessions struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
 	URI struct {
 		AccAddr  string `uri:"acc_addr"`
 		ID       uint64 `uri:"id"`
@@ -12,6 +25,8 @@ type RequestGetSessions struct {
 	}
 	Query struct {
 		Status string `form:"status" binding:"omitempty,oneof=active inactive_pending inactive"`
+		Sort   string `form:"sort"`
+		Cursor string `form:"cursor"`
 		Skip   int64  `form:"skip" binding:"gte=0"`
 		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
 	}
@@ -25,6 +40,15 @@ func NewRequestGetSessions(c *gin.Context) (req *RequestGetSessions, err error)
 	if err = c.ShouldBindQuery(&req.Query); err != nil {
 		return nil, err
 	}
+	if req.Sort, err = SessionFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = SessionFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
@@ -45,12 +69,18 @@ func NewRequestGetSession(c *gin.Context) (req *RequestGetSession, err error) {
 }
 
 type RequestGetSessionEvents struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
 	URI struct {
 		ID uint64 `uri:"id"`
 	}
 	Query struct {
-		Skip  int64 `form:"skip" binding:"gte=0"`
-		Limit int64 `form:"limit,default=25" binding:"gte=0,lte=100"`
+		Sort   string `form:"sort"`
+		Cursor string `form:"cursor"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
 	}
 }
 
@@ -62,6 +92,15 @@ func NewRequestGetSessionEvents(c *gin.Context) (req *RequestGetSessionEvents, e
 	if err = c.ShouldBindQuery(&req.Query); err != nil {
 		return nil, err
 	}
+	if req.Sort, err = utils.EventFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = utils.EventFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
@@ -0,0 +1,320 @@
+package statistics
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+var (
+	currencyRequestHandlers = RequestHandlers{
+		types.StatisticMethodAverageBytesPayment:           handleCurrencyAverage(types.StatisticTypeBytesPayment),
+		types.StatisticMethodAverageBytesStakingReward:     handleCurrencyAverage(types.StatisticTypeBytesStakingReward),
+		types.StatisticMethodAveragePlanPayment:            handleCurrencyAverage(types.StatisticTypePlanPayment),
+		types.StatisticMethodAveragePlanStakingReward:      handleCurrencyAverage(types.StatisticTypePlanStakingReward),
+		types.StatisticMethodAverageSubscriptionDeposit:    handleCurrencyAverage(types.StatisticTypeSubscriptionDeposit),
+		types.StatisticMethodHistoricalBytesPayment:        handleCurrencyHistorical(types.StatisticTypeBytesPayment),
+		types.StatisticMethodHistoricalBytesStakingReward:  handleCurrencyHistorical(types.StatisticTypeBytesStakingReward),
+		types.StatisticMethodHistoricalHoursPayment:        handleCurrencyHistorical(types.StatisticTypeHoursPayment),
+		types.StatisticMethodHistoricalHoursStakingReward:  handleCurrencyHistorical(types.StatisticTypeHoursStakingReward),
+		types.StatisticMethodHistoricalPlanPayment:         handleCurrencyHistorical(types.StatisticTypePlanPayment),
+		types.StatisticMethodHistoricalPlanStakingReward:   handleCurrencyHistorical(types.StatisticTypePlanStakingReward),
+		types.StatisticMethodHistoricalSubscriptionDeposit: handleCurrencyHistorical(types.StatisticTypeSubscriptionDeposit),
+		types.StatisticMethodTotalBytesPayment:             handleCurrencyTotal(types.StatisticTypeBytesPayment),
+		types.StatisticMethodTotalBytesStakingReward:       handleCurrencyTotal(types.StatisticTypeBytesStakingReward),
+		types.StatisticMethodTotalHoursPayment:             handleCurrencyTotal(types.StatisticTypeHoursPayment),
+		types.StatisticMethodTotalHoursStakingReward:       handleCurrencyTotal(types.StatisticTypeHoursStakingReward),
+		types.StatisticMethodTotalPlanPayment:              handleCurrencyTotal(types.StatisticTypePlanPayment),
+		types.StatisticMethodTotalPlanStakingReward:        handleCurrencyTotal(types.StatisticTypePlanStakingReward),
+		types.StatisticMethodTotalSubscriptionDeposit:      handleCurrencyTotal(types.StatisticTypeSubscriptionDeposit),
+	}
+)
+
+// bucketEndTimestamp returns the exclusive end of the bucket of the given timeframe starting at t.
+func bucketEndTimestamp(timeframe string, t time.Time) time.Time {
+	switch timeframe {
+	case "week":
+		return t.AddDate(0, 0, 7)
+	case "month":
+		return t.AddDate(0, 1, 0)
+	case "year":
+		return t.AddDate(1, 0, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+type currencyPrices map[string]map[time.Time]*models.Price
+
+// newCurrencyPrices loads the daily candles of the quote currency covering the buckets of the given items.
+func newCurrencyPrices(db *mongo.Database, req *RequestGetStatistics, items []bson.M) (currencyPrices, error) {
+	m := make(currencyPrices)
+	if len(items) == 0 {
+		return m, nil
+	}
+
+	var minTimestamp, maxTimestamp time.Time
+	for _, item := range items {
+		t := timestampFromStatistic(item)
+		if minTimestamp.IsZero() || t.Before(minTimestamp) {
+			minTimestamp = t
+		}
+		if t.After(maxTimestamp) {
+			maxTimestamp = t
+		}
+	}
+
+	filter := bson.M{
+		"quote":     req.Query.Currency,
+		"timeframe": "day",
+		"timestamp": bson.M{
+			"$gte": utils.DayDate(minTimestamp),
+			"$lt":  bucketEndTimestamp(req.Query.Timeframe, maxTimestamp),
+		},
+	}
+	projection := bson.M{
+		"_id":       0,
+		"denom":     1,
+		"exponent":  1,
+		"timestamp": 1,
+		"close":     1,
+	}
+
+	prices, err := database.PriceFind(context.TODO(), db, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, price := range prices {
+		if _, ok := m[price.Denom]; !ok {
+			m[price.Denom] = make(map[time.Time]*models.Price)
+		}
+
+		m[price.Denom][price.Timestamp.UTC()] = price
+	}
+
+	return m, nil
+}
+
+// Value converts the coins of a bucket using the average daily close price of each denom within the
+// bucket. The second return value is false when none of the coins has a price in the bucket.
+func (cp currencyPrices) Value(timeframe string, timestamp time.Time, coins types.Coins) (float64, bool) {
+	var (
+		found  = false
+		result = new(big.Float)
+	)
+
+	for _, coin := range coins {
+		days, ok := cp[coin.Denom]
+		if !ok {
+			continue
+		}
+
+		var (
+			count    int64
+			exponent int64
+			sum      float64
+		)
+
+		startTimestamp := utils.DayDate(timestamp.UTC())
+		endTimestamp := bucketEndTimestamp(timeframe, startTimestamp)
+		for t := startTimestamp; t.Before(endTimestamp); t = t.AddDate(0, 0, 1) {
+			price, ok := days[t]
+			if !ok {
+				continue
+			}
+
+			count, exponent, sum = count+1, price.Exponent, sum+price.Close
+		}
+
+		if count == 0 {
+			continue
+		}
+
+		amount, ok := new(big.Float).SetString(coin.Amount)
+		if !ok {
+			continue
+		}
+
+		divisor := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(exponent), nil))
+		amount = amount.Quo(amount, divisor)
+		amount = amount.Mul(amount, big.NewFloat(sum/float64(count)))
+
+		found, result = true, result.Add(result, amount)
+	}
+
+	value, _ := result.Float64()
+	return value, found
+}
+
+func timestampFromStatistic(item bson.M) time.Time {
+	switch v := item["timestamp"].(type) {
+	case primitive.DateTime:
+		return v.Time().UTC()
+	case time.Time:
+		return v.UTC()
+	default:
+		return time.Time{}
+	}
+}
+
+func coinsFromStatistic(item bson.M) (types.Coins, error) {
+	buf, err := bson.Marshal(bson.M{"value": item["value"]})
+	if err != nil {
+		return nil, err
+	}
+
+	var v struct {
+		Value types.Coins `bson:"value"`
+	}
+	if err := bson.Unmarshal(buf, &v); err != nil {
+		return nil, err
+	}
+
+	return v.Value, nil
+}
+
+func findCurrencyBuckets(db *mongo.Database, t string, req *RequestGetStatistics) ([]bson.M, error) {
+	filter := bson.M{
+		"type":      t,
+		"timeframe": req.Query.Timeframe,
+		"timestamp": bson.M{
+			"$gte": req.Query.FromTimestamp,
+			"$lt":  req.Query.ToTimestamp,
+		},
+	}
+	projection := bson.M{
+		"_id":       0,
+		"timestamp": 1,
+		"value":     1,
+	}
+	opts := options.Find().
+		SetProjection(projection).
+		SetSort(bson.D{bson.E{Key: "timestamp", Value: 1}})
+
+	return database.StatisticFind(context.TODO(), db, filter, opts)
+}
+
+// convertCurrencyBuckets replaces the coins value of each bucket with its value in the requested currency.
+// Buckets without any price are kept with a nil value, so that gaps in the price history remain visible.
+func convertCurrencyBuckets(db *mongo.Database, req *RequestGetStatistics, items []bson.M) ([]bson.M, error) {
+	prices, err := newCurrencyPrices(db, req, items)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		coins, err := coinsFromStatistic(item)
+		if err != nil {
+			return nil, err
+		}
+
+		timestamp := timestampFromStatistic(item)
+		if value, ok := prices.Value(req.Query.Timeframe, timestamp, coins); ok {
+			item["value"] = value
+		} else {
+			item["value"] = nil
+		}
+	}
+
+	return items, nil
+}
+
+func handleCurrencyHistorical(t string) func(*mongo.Database, *RequestGetStatistics) ([]bson.M, error) {
+	return func(db *mongo.Database, req *RequestGetStatistics) ([]bson.M, error) {
+		items, err := handleHistorical(db, t, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return convertCurrencyBuckets(db, req, items)
+	}
+}
+
+func handleCurrencyAverage(t string) func(*mongo.Database, *RequestGetStatistics) ([]bson.M, error) {
+	return func(db *mongo.Database, req *RequestGetStatistics) ([]bson.M, error) {
+		items, err := findCurrencyBuckets(db, t, req)
+		if err != nil {
+			return nil, err
+		}
+
+		items, err = convertCurrencyBuckets(db, req, items)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			count int64
+			sum   float64
+		)
+
+		for _, item := range items {
+			value, ok := item["value"].(float64)
+			if !ok {
+				continue
+			}
+
+			count, sum = count+1, sum+value
+		}
+
+		if count == 0 {
+			return []bson.M{}, nil
+		}
+
+		return []bson.M{
+			{
+				"_id":   req.Query.Currency,
+				"value": sum / float64(count),
+			},
+		}, nil
+	}
+}
+
+func handleCurrencyTotal(t string) func(*mongo.Database, *RequestGetStatistics) ([]bson.M, error) {
+	return func(db *mongo.Database, req *RequestGetStatistics) ([]bson.M, error) {
+		items, err := findCurrencyBuckets(db, t, req)
+		if err != nil {
+			return nil, err
+		}
+
+		items, err = convertCurrencyBuckets(db, req, items)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			count int64
+			sum   float64
+		)
+
+		for _, item := range items {
+			value, ok := item["value"].(float64)
+			if !ok {
+				continue
+			}
+
+			count, sum = count+1, sum+value
+		}
+
+		if count == 0 {
+			return []bson.M{}, nil
+		}
+
+		return []bson.M{
+			{
+				"_id":   req.Query.Currency,
+				"value": sum,
+			},
+		}, nil
+	}
+}
@@ -14,8 +14,14 @@ import (
 	"github.com/sentinel-official/explorer/types"
 )
 
-func HandlerGetStatistics(db *mongo.Database, excludeAddrs []string) gin.HandlerFunc {
-	requestHandlers := map[string]func(db *mongo.Database, req *RequestGetStatistics) ([]bson.M, error){
+// RequestHandler returns the result of a statistics method for the request.
+type RequestHandler func(db *mongo.Database, req *RequestGetStatistics) ([]bson.M, error)
+
+// RequestHandlers are the handlers of the statistics methods, keyed by method.
+type RequestHandlers map[string]RequestHandler
+
+func NewRequestHandlers(excludeAddrs []string) RequestHandlers {
+	return RequestHandlers{
 		types.StatisticMethodAverageActiveNodeCount:            handleAverageActiveNodeCount,
 		types.StatisticMethodAverageActiveSessionCount:         handleAverageActiveSessionCount,
 		types.StatisticMethodAverageActiveSubscriptionCount:    handleAverageActiveSubscriptionCount,
@@ -63,6 +69,28 @@ func HandlerGetStatistics(db *mongo.Database, excludeAddrs []string) gin.Handler
 		types.StatisticMethodTotalSessionDuration:              handleTotalSessionDuration,
 		types.StatisticMethodTotalSubscriptionDeposit:          handleTotalSubscriptionDeposit,
 	}
+}
+
+// Get returns the handler of the method of the request, or its currency
+// handler when the request asks for a currency.
+func (h RequestHandlers) Get(req *RequestGetStatistics) (RequestHandler, error) {
+	handlerFunc, ok := h[req.Query.Method]
+	if !ok {
+		return nil, fmt.Errorf("unknown method %s", req.Query.Method)
+	}
+
+	if req.Query.Currency != "" {
+		handlerFunc, ok = currencyRequestHandlers[req.Query.Method]
+		if !ok {
+			return nil, fmt.Errorf("method %s does not support currency", req.Query.Method)
+		}
+	}
+
+	return handlerFunc, nil
+}
+
+func HandlerGetStatistics(db *mongo.Database, excludeAddrs []string) gin.HandlerFunc {
+	requestHandlers := NewRequestHandlers(excludeAddrs)
 
 	return func(c *gin.Context) {
 		req, err := NewRequestGetStatistics(c)
@@ -71,9 +99,8 @@ func HandlerGetStatistics(db *mongo.Database, excludeAddrs []string) gin.Handler
 			return
 		}
 
-		handlerFunc, ok := requestHandlers[req.Query.Method]
-		if !ok {
-			err := fmt.Errorf("unknown method %s", req.Query.Method)
+		handlerFunc, err := requestHandlers.Get(req)
+		if err != nil {
 			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
 			return
 		}
@@ -1,9 +1,12 @@
 package statistics
 
 import (
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"go.mongodb.org/mongo-driver/bson"
 )
 
@@ -11,6 +14,7 @@ type RequestGetStatistics struct {
 	Sort bson.D
 
 	Query struct {
+		Currency      string    `form:"currency"`
 		FromTimestamp time.Time `form:"from_timestamp"`
 		Limit         int64     `form:"limit,default=30" binding:"gte=0,lte=100"`
 		Method        string    `form:"method" binding:"required"`
@@ -23,10 +27,21 @@ type RequestGetStatistics struct {
 }
 
 func NewRequestGetStatistics(c *gin.Context) (req *RequestGetStatistics, err error) {
+	return NewRequestGetStatisticsFromQuery(c.Request.URL.Query())
+}
+
+// NewRequestGetStatisticsFromQuery binds and validates the query parameters
+// the same way as a request to the statistics route.
+func NewRequestGetStatisticsFromQuery(query url.Values) (req *RequestGetStatistics, err error) {
 	req = &RequestGetStatistics{}
-	if err = c.ShouldBindQuery(&req.Query); err != nil {
+	if err = binding.MapFormWithTag(&req.Query, query, "form"); err != nil {
 		return nil, err
 	}
+	if err = binding.Validator.ValidateStruct(&req.Query); err != nil {
+		return nil, err
+	}
+
+	req.Query.Currency = strings.ToLower(req.Query.Currency)
 
 	validatorFunc, ok := validators[req.Query.Method]
 	if !ok {
@@ -0,0 +1,72 @@
+package stream
+
+import (
+	"slices"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/models"
+)
+
+// Filter selects the messages a subscriber receives. The blocks are sent as a
+// whole, while the events must match all the given fields and one of the types
+// when any is given.
+type Filter struct {
+	Blocks         bool
+	Events         bool
+	Types          []string
+	AccAddr        string
+	NodeAddr       string
+	SubscriptionID uint64
+}
+
+// eventFilter returns the query of the events matching the filter, for the
+// messages which are read back from the database when a subscriber resumes.
+func (f *Filter) eventFilter() bson.M {
+	filter := bson.M{}
+	if len(f.Types) > 0 {
+		filter["type"] = bson.M{
+			"$in": f.Types,
+		}
+	}
+	if f.AccAddr != "" {
+		filter["acc_addr"] = f.AccAddr
+	}
+	if f.NodeAddr != "" {
+		filter["node_addr"] = f.NodeAddr
+	}
+	if f.SubscriptionID != 0 {
+		filter["subscription_id"] = f.SubscriptionID
+	}
+
+	return filter
+}
+
+func (f *Filter) matchEvent(v *models.Event) bool {
+	if len(f.Types) > 0 && !slices.Contains(f.Types, v.Type) {
+		return false
+	}
+	if f.AccAddr != "" && f.AccAddr != v.AccAddr {
+		return false
+	}
+	if f.NodeAddr != "" && f.NodeAddr != v.NodeAddr {
+		return false
+	}
+	if f.SubscriptionID != 0 && f.SubscriptionID != v.SubscriptionID {
+		return false
+	}
+
+	return true
+}
+
+// Match reports whether the message is selected by the filter.
+func (f *Filter) Match(m *Message) bool {
+	switch v := m.Result.(type) {
+	case *models.Block:
+		return f.Blocks
+	case *models.Event:
+		return f.Events && f.matchEvent(v)
+	default:
+		return false
+	}
+}
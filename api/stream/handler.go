@@ -0,0 +1,88 @@
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+var errTooSlow = errors.New("subscriber is too slow, resume from the last height received")
+
+// HandlerGetStream streams the blocks and events matching the query, over a
+// WebSocket when the request asks for an upgrade and as server-sent events
+// otherwise. With a from height, within the latest heights, the messages since
+// that height are read back before the new ones are sent.
+func HandlerGetStream(hub *Hub) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetStream(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		height, err := hub.Height()
+		if err != nil {
+			c.JSON(http.StatusServiceUnavailable, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		if req.Query.FromHeight > 0 && req.Query.FromHeight <= height-maxBackfillHeights {
+			err = fmt.Errorf("from height must be within the latest %d heights", maxBackfillHeights)
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		var (
+			ctx = c.Request.Context()
+			w   writer
+		)
+
+		if websocket.IsWebSocketUpgrade(c.Request) {
+			var ww *wsWriter
+			if ww, ctx, err = newWSWriter(ctx, c); err != nil {
+				return
+			}
+
+			w = ww
+		} else {
+			w = newSSEWriter(c)
+		}
+
+		s, err := hub.Resume(ctx, req.Filter(), req.Query.FromHeight, w.Write)
+		if err != nil {
+			w.Close(err)
+			return
+		}
+
+		defer hub.Unsubscribe(s)
+
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				w.Close(nil)
+				return
+			case <-s.Done():
+				w.Close(errTooSlow)
+				return
+			case m := <-s.C():
+				err = w.Write(m)
+			case <-ticker.C:
+				err = w.Ping()
+			}
+
+			if err != nil {
+				w.Close(err)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+)
+
+const (
+	pollInterval     = time.Second
+	maxPollHeights   = 100
+	subscriberBuffer = 1024
+
+	// maxBackfillHeights is the number of the latest heights which can be
+	// read back, the earlier ones are to be queried from the REST API.
+	maxBackfillHeights = 10_000
+)
+
+// syncAppNames are the apps whose commits are streamed. A height is streamed
+// once all of them have committed it, so its block and events are sent
+// together.
+var syncAppNames = []string{
+	"01_tendermint",
+	"03_sentinelhub",
+}
+
+var errNotReady = errors.New("stream is not ready")
+
+// Subscriber receives the messages of the heights streamed after it
+// subscribed, which match its filter.
+type Subscriber struct {
+	filter *Filter
+	c      chan *Message
+	done   chan struct{}
+}
+
+// C returns the channel of the messages of the subscriber.
+func (s *Subscriber) C() <-chan *Message { return s.c }
+
+// Done returns a channel which is closed when the subscriber falls too far
+// behind and is dropped by the hub.
+func (s *Subscriber) Done() <-chan struct{} { return s.done }
+
+// send sends the messages matching the filter of the subscriber, and returns
+// false when its buffer is full.
+func (s *Subscriber) send(msgs []*Message) bool {
+	for _, m := range msgs {
+		if !s.filter.Match(m) {
+			continue
+		}
+
+		select {
+		case s.c <- m:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// Hub polls the sync status of the apps and sends the blocks and events of the
+// newly committed heights to its subscribers.
+type Hub struct {
+	db *mongo.Database
+
+	mu          sync.Mutex
+	ready       bool
+	height      int64
+	subscribers map[*Subscriber]struct{}
+}
+
+func NewHub(db *mongo.Database) *Hub {
+	return &Hub{
+		db:          db,
+		subscribers: make(map[*Subscriber]struct{}),
+	}
+}
+
+// Run polls the sync status until the context is done.
+func (h *Hub) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := h.poll(ctx); err != nil {
+			log.Println(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// syncHeight returns the height committed by all the apps, which is not known
+// until all of them have synced once.
+func (h *Hub) syncHeight(ctx context.Context) (int64, bool, error) {
+	height := int64(math.MaxInt64)
+	for _, appName := range syncAppNames {
+		filter := bson.M{
+			"app_name": appName,
+		}
+
+		item, err := database.SyncStatusFindOne(ctx, h.db, filter)
+		if err != nil {
+			return 0, false, err
+		}
+		if item == nil {
+			return 0, false, nil
+		}
+
+		height = min(height, item.Height)
+	}
+
+	return height, true, nil
+}
+
+func (h *Hub) poll(ctx context.Context) error {
+	to, ok, err := h.syncHeight(ctx)
+	if err != nil || !ok {
+		return err
+	}
+
+	h.mu.Lock()
+	ready, from := h.ready, h.height+1
+	h.mu.Unlock()
+
+	// The hub starts at the current height, the earlier ones are read back
+	// by the subscribers which resume. A rolled back height is streamed
+	// again once it is committed anew.
+	if !ready || to < from-1 {
+		h.publish(to, nil)
+		return nil
+	}
+
+	for from <= to {
+		end := min(to, from+maxPollHeights-1)
+
+		msgs, err := findMessages(ctx, h.db, from, end, nil)
+		if err != nil {
+			return err
+		}
+
+		h.publish(end, msgs)
+		from = end + 1
+	}
+
+	return nil
+}
+
+// publish sends the messages to the subscribers and moves the hub to the
+// height. A subscriber whose buffer is full is dropped, so a slow client can
+// not hold back the others.
+func (h *Hub) publish(height int64, msgs []*Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.ready = true
+	h.height = height
+
+	for s := range h.subscribers {
+		if !s.send(msgs) {
+			delete(h.subscribers, s)
+			close(s.done)
+		}
+	}
+}
+
+// Height returns the height streamed so far.
+func (h *Hub) Height() (int64, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.ready {
+		return 0, errNotReady
+	}
+
+	return h.height, nil
+}
+
+// Subscribe adds a subscriber with the filter. It returns the height streamed
+// so far, the messages of the later heights being sent to the subscriber.
+func (h *Hub) Subscribe(f *Filter) (*Subscriber, int64, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.ready {
+		return nil, 0, errNotReady
+	}
+
+	s := &Subscriber{
+		filter: f,
+		c:      make(chan *Message, subscriberBuffer),
+		done:   make(chan struct{}),
+	}
+
+	h.subscribers[s] = struct{}{}
+	return s, h.height, nil
+}
+
+func (h *Hub) Unsubscribe(s *Subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.subscribers, s)
+}
+
+// Backfill reads back the messages of the heights from and to, both included,
+// which match the filter.
+func (h *Hub) Backfill(ctx context.Context, f *Filter, from, to int64, fn func(m *Message) error) error {
+	for from <= to {
+		end := min(to, from+maxPollHeights-1)
+
+		msgs, err := findMessages(ctx, h.db, from, end, f)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range msgs {
+			if err := fn(m); err != nil {
+				return err
+			}
+		}
+
+		from = end + 1
+	}
+
+	return nil
+}
+
+// Resume reads back the messages matching the filter since the from height,
+// and subscribes once it is within a few heights of the hub. Long ranges are
+// read back before subscribing, so the live messages do not fill the buffer of
+// the subscriber meanwhile. A zero from height subscribes right away.
+func (h *Hub) Resume(ctx context.Context, f *Filter, from int64, fn func(m *Message) error) (*Subscriber, error) {
+	for from > 0 {
+		to, err := h.Height()
+		if err != nil {
+			return nil, err
+		}
+		if to-from < maxPollHeights {
+			break
+		}
+
+		if err := h.Backfill(ctx, f, from, to, fn); err != nil {
+			return nil, err
+		}
+
+		from = to + 1
+	}
+
+	s, to, err := h.Subscribe(f)
+	if err != nil {
+		return nil, err
+	}
+
+	if from > 0 {
+		if err := h.Backfill(ctx, f, from, to, fn); err != nil {
+			h.Unsubscribe(s)
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,92 @@
+package stream
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+)
+
+const (
+	MessageTypeBlock = "block"
+	MessageTypeEvent = "event"
+)
+
+// Message is a block or an event sent to the subscribers, along with the
+// height it was committed at.
+type Message struct {
+	Type   string      `json:"type"`
+	Height int64       `json:"height"`
+	Result interface{} `json:"result"`
+}
+
+// findMessages returns the messages of the heights from and to, both included,
+// ordered by height with the block of a height before its events. Without a
+// filter all the blocks and events are returned.
+func findMessages(ctx context.Context, db *mongo.Database, from, to int64, f *Filter) ([]*Message, error) {
+	height := bson.M{
+		"$gte": from,
+		"$lte": to,
+	}
+
+	var blocks []*models.Block
+	if f == nil || f.Blocks {
+		filter := bson.M{
+			"height": height,
+		}
+		projection := bson.M{
+			"height":           1,
+			"time":             1,
+			"proposer_address": 1,
+			"num_txs":          1,
+			"duration":         1,
+		}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(bson.D{{Key: "height", Value: 1}})
+
+		items, err := database.BlockFind(ctx, db, filter, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		blocks = items
+	}
+
+	var events []*models.Event
+	if f == nil || f.Events {
+		filter := bson.M{}
+		if f != nil {
+			filter = f.eventFilter()
+		}
+
+		filter["height"] = height
+
+		opts := options.Find().
+			SetSort(bson.D{{Key: "height", Value: 1}, {Key: "_id", Value: 1}})
+
+		items, err := database.EventFind(ctx, db, filter, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		events = items
+	}
+
+	msgs := make([]*Message, 0, len(blocks)+len(events))
+	for i, j := 0, 0; i < len(blocks) || j < len(events); {
+		if j == len(events) || (i < len(blocks) && blocks[i].Height <= events[j].Height) {
+			msgs = append(msgs, &Message{Type: MessageTypeBlock, Height: blocks[i].Height, Result: blocks[i]})
+			i++
+		} else {
+			msgs = append(msgs, &Message{Type: MessageTypeEvent, Height: events[j].Height, Result: events[j]})
+			j++
+		}
+	}
+
+	return msgs, nil
+}
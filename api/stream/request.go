@@ -0,0 +1,48 @@
+package stream
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RequestGetStream struct {
+	Query struct {
+		Blocks         bool     `form:"blocks"`
+		Events         bool     `form:"events,default=true"`
+		FromHeight     int64    `form:"from_height" binding:"gte=0"`
+		Types          []string `form:"type"`
+		AccAddr        string   `form:"acc_addr"`
+		NodeAddr       string   `form:"node_addr"`
+		SubscriptionID uint64   `form:"subscription_id"`
+	}
+}
+
+func NewRequestGetStream(c *gin.Context) (req *RequestGetStream, err error) {
+	req = &RequestGetStream{}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+
+	// An event source which reconnects resumes from the height of the last
+	// message it received, which is sent again as the other messages of the
+	// height may not have been.
+	if id := c.GetHeader("Last-Event-ID"); id != "" {
+		if req.Query.FromHeight, err = strconv.ParseInt(id, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+
+	return req, nil
+}
+
+func (r *RequestGetStream) Filter() *Filter {
+	return &Filter{
+		Blocks:         r.Query.Blocks,
+		Events:         r.Query.Events,
+		Types:          r.Query.Types,
+		AccAddr:        r.Query.AccAddr,
+		NodeAddr:       r.Query.NodeAddr,
+		SubscriptionID: r.Query.SubscriptionID,
+	}
+}
@@ -0,0 +1,9 @@
+package stream
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterRoutes(router gin.IRouter, hub *Hub) {
+	router.GET("/stream", HandlerGetStream(hub))
+}
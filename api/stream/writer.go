@@ -0,0 +1,134 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	pingInterval = 30 * time.Second
+	writeTimeout = 10 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	// The origins are checked by the CORS middleware of the router, which
+	// allows all of them.
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// writer sends the messages to a client over either of the transports.
+type writer interface {
+	Write(m *Message) error
+	Ping() error
+	Close(err error)
+}
+
+type sseWriter struct {
+	c *gin.Context
+}
+
+func newSSEWriter(c *gin.Context) *sseWriter {
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	return &sseWriter{c: c}
+}
+
+// Write sends the message as an event named after its type, with the height as
+// its id so an event source which reconnects resumes from it.
+func (w *sseWriter) Write(m *Message) error {
+	event := sse.Event{
+		Event: m.Type,
+		Id:    strconv.FormatInt(m.Height, 10),
+		Data:  m,
+	}
+
+	if err := sse.Encode(w.c.Writer, event); err != nil {
+		return err
+	}
+
+	w.c.Writer.Flush()
+	return nil
+}
+
+func (w *sseWriter) Ping() error {
+	if _, err := fmt.Fprint(w.c.Writer, ": ping\n\n"); err != nil {
+		return err
+	}
+
+	w.c.Writer.Flush()
+	return nil
+}
+
+func (w *sseWriter) Close(err error) {
+	if err == nil {
+		return
+	}
+
+	event := sse.Event{
+		Event: "error",
+		Data:  err.Error(),
+	}
+
+	_ = sse.Encode(w.c.Writer, event)
+	w.c.Writer.Flush()
+}
+
+type wsWriter struct {
+	conn *websocket.Conn
+}
+
+// newWSWriter upgrades the connection and returns a context which is done
+// once the client closes it, as the messages of the client are discarded.
+func newWSWriter(ctx context.Context, c *gin.Context) (*wsWriter, context.Context, error) {
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	return &wsWriter{conn: conn}, ctx, nil
+}
+
+func (w *wsWriter) Write(m *Message) error {
+	if err := w.conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return err
+	}
+
+	return w.conn.WriteJSON(m)
+}
+
+func (w *wsWriter) Ping() error {
+	return w.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout))
+}
+
+func (w *wsWriter) Close(err error) {
+	code, text := websocket.CloseNormalClosure, ""
+	if err != nil {
+		code, text = websocket.CloseInternalServerErr, err.Error()
+	}
+
+	msg := websocket.FormatCloseMessage(code, text)
+	_ = w.conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(writeTimeout))
+	_ = w.conn.Close()
+}
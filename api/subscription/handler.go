@@ -11,6 +11,7 @@ import (
 
 	"github.com/sentinel-official/explorer/database"
 	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
 )
 
 func HandlerGetSubscriptions(db *mongo.Database) gin.HandlerFunc {
@@ -35,19 +36,22 @@ func HandlerGetSubscriptions(db *mongo.Database) gin.HandlerFunc {
 			filter["node_address"] = req.URI.NodeAddr
 		}
 
+		utils.MergeFilter(filter, req.Filter)
+
 		projection := bson.M{}
 		opts := options.Find().
 			SetProjection(projection).
+			SetSort(req.Sort).
 			SetSkip(req.Query.Skip).
 			SetLimit(req.Query.Limit)
 
-		items, err := database.SubscriptionFind(context.TODO(), db, filter, opts)
+		items, page, err := database.SubscriptionFindPage(context.TODO(), db, filter, req.Cursor, opts)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
 			return
 		}
 
-		c.JSON(http.StatusOK, types.NewResponseResult(items))
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
 	}
 }
 
@@ -93,19 +97,23 @@ func HandlerGetSubscriptionEvents(db *mongo.Database) gin.HandlerFunc {
 			},
 			"subscription_id": req.URI.ID,
 		}
+
+		utils.MergeFilter(filter, req.Filter)
+
 		projection := bson.M{}
 		opts := options.Find().
 			SetProjection(projection).
+			SetSort(req.Sort).
 			SetSkip(req.Query.Skip).
 			SetLimit(req.Query.Limit)
 
-		items, err := database.EventFind(context.TODO(), db, filter, opts)
+		items, page, err := database.EventFindPage(context.TODO(), db, filter, req.Cursor, opts)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
 			return
 		}
 
-		c.JSON(http.StatusOK, types.NewResponseResult(items))
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
 	}
 }
 
@@ -120,19 +128,22 @@ func HandlerGetAllocations(db *mongo.Database) gin.HandlerFunc {
 		filter := bson.M{
 			"id": req.URI.ID,
 		}
+
+		utils.MergeFilter(filter, req.Filter)
+
 		projection := bson.M{}
 		opts := options.Find().
 			SetProjection(projection).
 			SetSkip(req.Query.Skip).
 			SetLimit(req.Query.Limit)
 
-		items, err := database.SubscriptionAllocationFind(context.TODO(), db, filter, opts)
+		items, page, err := database.SubscriptionAllocationFindPage(context.TODO(), db, filter, req.Cursor, opts)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
 			return
 		}
 
-		c.JSON(http.StatusOK, types.NewResponseResult(items))
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
 	}
 }
 
@@ -179,18 +190,22 @@ func HandlerGetAllocationEvents(db *mongo.Database) gin.HandlerFunc {
 			"subscription_id": req.URI.ID,
 			"acc_address":     req.URI.AccAddr,
 		}
+
+		utils.MergeFilter(filter, req.Filter)
+
 		projection := bson.M{}
 		opts := options.Find().
 			SetProjection(projection).
+			SetSort(req.Sort).
 			SetSkip(req.Query.Skip).
 			SetLimit(req.Query.Limit)
 
-		items, err := database.EventFind(context.TODO(), db, filter, opts)
+		items, page, err := database.EventFindPage(context.TODO(), db, filter, req.Cursor, opts)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
 			return
 		}
 
-		c.JSON(http.StatusOK, types.NewResponseResult(items))
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
 	}
 }
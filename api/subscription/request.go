@@ -2,9 +2,28 @@ package subscription
 
 import (
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/utils"
+)
+
+var (
+	allocationFields = utils.Fields{
+		"acc_addr": {Type: utils.FieldTypeString, Filter: true},
+	}
+	SubscriptionFields = utils.Fields{
+		"acc_addr":  {Type: utils.FieldTypeString, Filter: true},
+		"id":        {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+		"node_addr": {Type: utils.FieldTypeString, Filter: true},
+		"plan_id":   {Type: utils.FieldTypeInt, Filter: true},
+	}
 )
 
 type RequestGetSubscriptions struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
 	URI struct {
 		AccAddr  string `uri:"acc_addr"`
 		ID       uint64 `uri:"id"`
@@ -12,6 +31,8 @@ type RequestGetSubscriptions struct {
 	}
 	Query struct {
 		Status string `form:"status" binding:"omitempty,oneof=active inactive_pending inactive"`
+		Sort   string `form:"sort"`
+		Cursor string `form:"cursor"`
 		Skip   int64  `form:"skip" binding:"gte=0"`
 		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
 	}
@@ -25,6 +46,15 @@ func NewRequestGetSubscriptions(c *gin.Context) (req *RequestGetSubscriptions, e
 	if err = c.ShouldBindQuery(&req.Query); err != nil {
 		return nil, err
 	}
+	if req.Sort, err = SubscriptionFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = SubscriptionFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
@@ -45,12 +75,18 @@ func NewRequestGetSubscription(c *gin.Context) (req *RequestGetSubscription, err
 }
 
 type RequestGetSubscriptionEvents struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
 	URI struct {
 		ID uint64 `uri:"id"`
 	}
 	Query struct {
-		Skip  int64 `form:"skip" binding:"gte=0"`
-		Limit int64 `form:"limit,default=25" binding:"gte=0,lte=100"`
+		Sort   string `form:"sort"`
+		Cursor string `form:"cursor"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
 	}
 }
 
@@ -62,17 +98,30 @@ func NewRequestGetSubscriptionEvents(c *gin.Context) (req *RequestGetSubscriptio
 	if err = c.ShouldBindQuery(&req.Query); err != nil {
 		return nil, err
 	}
+	if req.Sort, err = utils.EventFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = utils.EventFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
 
 type RequestGetAllocations struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+
 	URI struct {
 		ID uint64 `uri:"id"`
 	}
 	Query struct {
-		Skip  int64 `form:"skip" binding:"gte=0"`
-		Limit int64 `form:"limit,default=25" binding:"gte=0,lte=100"`
+		Cursor string `form:"cursor"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
 	}
 }
 
@@ -84,6 +133,12 @@ func NewRequestGetAllocations(c *gin.Context) (req *RequestGetAllocations, err e
 	if err = c.ShouldBindQuery(&req.Query); err != nil {
 		return nil, err
 	}
+	if req.Filter, err = allocationFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(nil, req.Query.Cursor); err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
@@ -105,13 +160,19 @@ func NewRequestGetAllocation(c *gin.Context) (req *RequestGetAllocation, err err
 }
 
 type RequestGetAllocationEvents struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+
 	URI struct {
 		AccAddr string `uri:"acc_addr"`
 		ID      uint64 `uri:"id"`
 	}
 	Query struct {
-		Skip  int64 `form:"skip" binding:"gte=0"`
-		Limit int64 `form:"limit,default=25" binding:"gte=0,lte=100"`
+		Sort   string `form:"sort"`
+		Cursor string `form:"cursor"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
 	}
 }
 
@@ -123,6 +184,15 @@ func NewRequestGetAllocationEvents(c *gin.Context) (req *RequestGetAllocationEve
 	if err = c.ShouldBindQuery(&req.Query); err != nil {
 		return nil, err
 	}
+	if req.Sort, err = utils.EventFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = utils.EventFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
 
 	return req, nil
 }
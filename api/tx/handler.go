@@ -11,6 +11,7 @@ import (
 
 	"github.com/sentinel-official/explorer/database"
 	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
 )
 
 func HandlerGetTxs(db *mongo.Database) gin.HandlerFunc {
@@ -41,6 +42,17 @@ func HandlerGetTxs(db *mongo.Database) gin.HandlerFunc {
 		if req.URI.Height != 0 {
 			filter["height"] = req.URI.Height
 		}
+		if req.Query.MsgType != "" {
+			filter["msg_types"] = req.Query.MsgType
+		}
+		if req.Query.Signer != "" {
+			filter["signers"] = req.Query.Signer
+		}
+		if req.URI.AccAddr != "" {
+			filter["signers"] = req.URI.AccAddr
+		}
+
+		utils.MergeFilter(filter, req.Filter)
 
 		projection := bson.M{
 			"hash":                 1,
@@ -51,6 +63,7 @@ func HandlerGetTxs(db *mongo.Database) gin.HandlerFunc {
 			"gas_limit":            1,
 			"payer":                1,
 			"memo":                 1,
+			"msg_types":            1,
 			"result.code":          1,
 			"result.codespace":     1,
 			"result.gas_wanted":    1,
@@ -58,16 +71,17 @@ func HandlerGetTxs(db *mongo.Database) gin.HandlerFunc {
 		}
 		opts := options.Find().
 			SetProjection(projection).
+			SetSort(req.Sort).
 			SetSkip(req.Query.Skip).
 			SetLimit(req.Query.Limit)
 
-		items, err := database.TxFind(context.TODO(), db, filter, opts)
+		items, page, err := database.TxFindPage(context.TODO(), db, filter, req.Cursor, opts)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
 			return
 		}
 
-		c.JSON(http.StatusOK, types.NewResponseResult(items))
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
 	}
 }
 
@@ -108,3 +122,53 @@ func HandlerGetTx(db *mongo.Database) gin.HandlerFunc {
 		c.JSON(http.StatusOK, types.NewResponseResult(item))
 	}
 }
+
+func HandlerGetTxDecoded(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetTx(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{
+			"hash": req.URI.Hash,
+		}
+		projection := bson.M{
+			"hash":             1,
+			"height":           1,
+			"timestamp":        1,
+			"messages":         1,
+			"result.code":      1,
+			"result.codespace": 1,
+			"result.events":    1,
+			"result.log":       1,
+		}
+		opts := options.FindOne().
+			SetProjection(projection)
+
+		item, err := database.TxFindOne(context.TODO(), db, filter, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+		if item == nil {
+			c.JSON(http.StatusOK, types.NewResponseResult(nil))
+			return
+		}
+
+		filter = bson.M{
+			"tx_hash": item.Hash,
+		}
+		findOpts := options.Find().
+			SetSort(bson.D{bson.E{Key: "_id", Value: 1}})
+
+		events, err := database.EventFind(context.TODO(), db, filter, findOpts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(NewResponseDecodedTx(item, events)))
+	}
+}
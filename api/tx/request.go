@@ -2,23 +2,53 @@ package tx
 
 import (
 	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/utils"
 )
 
+var TxFields = utils.Fields{
+	"code":     {Key: "result.code", Type: utils.FieldTypeInt, Filter: true},
+	"height":   {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+	"msg_type": {Key: "msg_types", Type: utils.FieldTypeString, Filter: true},
+	"signer":   {Key: "signers", Type: utils.FieldTypeString, Filter: true},
+}
+
 type RequestGetTxs struct {
-	URI struct {
-		Height int64 `uri:"height"`
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+	URI    struct {
+		AccAddr string `uri:"acc_addr"`
+		Height  int64  `uri:"height"`
 	}
 	Query struct {
-		FromHeight int64 `form:"from_height"`
-		ToHeight   int64 `form:"to_height,default=1000000000"`
-		Skip       int64 `form:"skip" binding:"gte=0"`
-		Limit      int64 `form:"limit,default=25" binding:"gte=0,lte=100"`
+		FromHeight int64  `form:"from_height"`
+		ToHeight   int64  `form:"to_height,default=1000000000"`
+		MsgType    string `form:"msg_type"`
+		Signer     string `form:"signer"`
+		Cursor     string `form:"cursor"`
+		Sort       string `form:"sort"`
+		Skip       int64  `form:"skip" binding:"gte=0"`
+		Limit      int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
 	}
 }
 
 func NewRequestGetTxs(c *gin.Context) (req *RequestGetTxs, err error) {
 	req = &RequestGetTxs{}
-	if err := c.ShouldBindQuery(&req.Query); err != nil {
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = TxFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = TxFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
 		return nil, err
 	}
 
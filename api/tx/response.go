@@ -1 +1,112 @@
 package tx
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+type ResponseDecodedMessage struct {
+	Index    int             `json:"index"`
+	Type     string          `json:"type"`
+	Data     bson.M          `json:"data"`
+	Entities *types.Entities `json:"entities"`
+	Events   []*models.Event `json:"events"`
+}
+
+type ResponseDecodedTx struct {
+	Hash      string                    `json:"hash"`
+	Height    int64                     `json:"height"`
+	Timestamp time.Time                 `json:"timestamp"`
+	Code      uint32                    `json:"code"`
+	Codespace string                    `json:"codespace,omitempty"`
+	Messages  []*ResponseDecodedMessage `json:"messages"`
+	Events    []*models.Event           `json:"events,omitempty"`
+}
+
+// NewResponseDecodedTx returns the messages of the transaction along with the
+// entities found in the events each message emitted, taken from the message
+// logs. The indexed events of the transaction are attached to the first
+// message which references their entity, or to the only message, and the
+// others are left at the top.
+func NewResponseDecodedTx(v *models.Tx, events []*models.Event) *ResponseDecodedTx {
+	res := &ResponseDecodedTx{
+		Hash:      v.Hash,
+		Height:    v.Height,
+		Timestamp: v.Timestamp,
+		Messages:  make([]*ResponseDecodedMessage, 0, len(v.Messages)),
+	}
+
+	msgEvents := make(map[int]types.Events)
+	if v.Result != nil {
+		res.Code = v.Result.Code
+		res.Codespace = v.Result.Codespace
+
+		var logs sdk.ABCIMessageLogs
+		if err := json.Unmarshal([]byte(v.Result.Log), &logs); err == nil {
+			for i := 0; i < len(logs); i++ {
+				log := types.NewABCIMessageLog(&logs[i])
+				msgEvents[int(log.Index)] = log.Events
+			}
+		} else if len(v.Messages) == 1 {
+			msgEvents[0] = v.Result.Events
+		}
+	}
+
+	for i := 0; i < len(v.Messages); i++ {
+		res.Messages = append(res.Messages, &ResponseDecodedMessage{
+			Index:    i,
+			Type:     v.Messages[i].Type,
+			Data:     v.Messages[i].Data,
+			Entities: types.NewEntitiesFromEvents(msgEvents[i]),
+			Events:   []*models.Event{},
+		})
+	}
+
+	for _, event := range events {
+		attached := false
+		for _, msg := range res.Messages {
+			if references(msg.Entities, event) {
+				msg.Events = append(msg.Events, event)
+				attached = true
+				break
+			}
+		}
+
+		if !attached && len(res.Messages) == 1 {
+			res.Messages[0].Events = append(res.Messages[0].Events, event)
+			attached = true
+		}
+		if !attached {
+			res.Events = append(res.Events, event)
+		}
+	}
+
+	return res
+}
+
+// references reports whether the event is about one of the entities, checking
+// the most specific identifier of the event first.
+func references(v *types.Entities, e *models.Event) bool {
+	switch {
+	case e.SessionID != 0:
+		return v.HasSessionID(e.SessionID)
+	case e.SubscriptionID != 0:
+		return v.HasSubscriptionID(e.SubscriptionID)
+	case e.PlanID != 0:
+		return v.HasPlanID(e.PlanID)
+	case e.NodeAddr != "":
+		return v.HasNodeAddr(e.NodeAddr)
+	case e.ProvAddr != "":
+		return v.HasProvAddr(e.ProvAddr)
+	case e.AccAddr != "":
+		return v.HasAccAddr(e.AccAddr)
+	default:
+		return false
+	}
+}
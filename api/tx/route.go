@@ -6,8 +6,11 @@ import (
 )
 
 func RegisterRoutes(router gin.IRouter, db *mongo.Database) {
+	router.GET("/accounts/:acc_addr/txs", HandlerGetTxs(db))
+
 	router.GET("/blocks/:height/txs", HandlerGetTxs(db))
 
 	router.GET("/txs", HandlerGetTxs(db))
 	router.GET("/txs/:hash", HandlerGetTx(db))
+	router.GET("/txs/:hash/decoded", HandlerGetTxDecoded(db))
 }
@@ -0,0 +1,135 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+// addrFilter matches a validator by either its operator address or its
+// hex encoded consensus address.
+func addrFilter(addr string) bson.M {
+	return bson.M{
+		"$or": bson.A{
+			bson.M{"oper_addr": addr},
+			bson.M{"cons_addr": strings.ToUpper(addr)},
+		},
+	}
+}
+
+func HandlerGetValidators(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetValidators(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := bson.M{}
+		if req.Query.Status != "" {
+			filter["status"] = req.Query.Status
+		}
+
+		utils.MergeFilter(filter, req.Filter)
+
+		projection := bson.M{}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.ValidatorFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(NewResponseValidators(items)).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
+
+func HandlerGetValidator(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetValidator(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := addrFilter(req.URI.Addr)
+		projection := bson.M{}
+		opts := options.FindOne().
+			SetProjection(projection)
+
+		item, err := database.ValidatorFindOne(context.TODO(), db, filter, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+		if item == nil {
+			c.JSON(http.StatusOK, types.NewResponseResult(nil))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(NewResponseValidator(item)))
+	}
+}
+
+func HandlerGetValidatorBlocks(db *mongo.Database) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req, err := NewRequestGetValidatorBlocks(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, types.NewResponseError(1, err.Error()))
+			return
+		}
+
+		filter := addrFilter(req.URI.Addr)
+		projection := bson.M{
+			"cons_addr": 1,
+		}
+
+		validator, err := database.ValidatorFindOne(context.TODO(), db, filter, options.FindOne().SetProjection(projection))
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+		if validator == nil {
+			c.JSON(http.StatusOK, types.NewResponseResult(nil))
+			return
+		}
+
+		filter = bson.M{
+			"cons_addr": validator.ConsAddr,
+		}
+		if req.Query.Type != "" {
+			filter[req.Query.Type] = true
+		}
+
+		utils.MergeFilter(filter, req.Filter)
+
+		projection = bson.M{}
+		opts := options.Find().
+			SetProjection(projection).
+			SetSort(req.Sort).
+			SetSkip(req.Query.Skip).
+			SetLimit(req.Query.Limit)
+
+		items, page, err := database.ValidatorBlockFindPage(context.TODO(), db, filter, req.Cursor, opts)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, types.NewResponseError(2, err.Error()))
+			return
+		}
+
+		c.JSON(http.StatusOK, types.NewResponseResult(items).WithPagination(page.Pagination(c.Request.URL)))
+	}
+}
@@ -0,0 +1,108 @@
+package validator
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/utils"
+)
+
+var (
+	blockFields = utils.Fields{
+		"height":   {Type: utils.FieldTypeInt, Filter: true, Sort: true},
+		"missed":   {Type: utils.FieldTypeBool, Filter: true},
+		"proposed": {Type: utils.FieldTypeBool, Filter: true},
+	}
+	validatorFields = utils.Fields{
+		"cons_addr":       {Type: utils.FieldTypeString, Filter: true},
+		"missed_blocks":   {Type: utils.FieldTypeInt, Sort: true},
+		"oper_addr":       {Type: utils.FieldTypeString, Filter: true},
+		"proposed_blocks": {Type: utils.FieldTypeInt, Sort: true},
+		"signed_blocks":   {Type: utils.FieldTypeInt, Sort: true},
+		"voting_power":    {Type: utils.FieldTypeInt, Sort: true},
+	}
+)
+
+type RequestGetValidators struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+
+	Sort  bson.D
+	Query struct {
+		Status string `form:"status"`
+		Sort   string `form:"sort,default=-voting_power"`
+		Cursor string `form:"cursor"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetValidators(c *gin.Context) (req *RequestGetValidators, err error) {
+	req = &RequestGetValidators{}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = validatorFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = validatorFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetValidator struct {
+	URI struct {
+		Addr string `uri:"addr"`
+	}
+}
+
+func NewRequestGetValidator(c *gin.Context) (req *RequestGetValidator, err error) {
+	req = &RequestGetValidator{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+type RequestGetValidatorBlocks struct {
+	Cursor *utils.Cursor
+	Filter bson.M
+	Sort   bson.D
+	URI    struct {
+		Addr string `uri:"addr"`
+	}
+	Query struct {
+		Type   string `form:"type" binding:"omitempty,oneof=proposed missed"`
+		Cursor string `form:"cursor"`
+		Sort   string `form:"sort,default=-height"`
+		Skip   int64  `form:"skip" binding:"gte=0"`
+		Limit  int64  `form:"limit,default=25" binding:"gte=0,lte=100"`
+	}
+}
+
+func NewRequestGetValidatorBlocks(c *gin.Context) (req *RequestGetValidatorBlocks, err error) {
+	req = &RequestGetValidatorBlocks{}
+	if err = c.ShouldBindUri(&req.URI); err != nil {
+		return nil, err
+	}
+	if err = c.ShouldBindQuery(&req.Query); err != nil {
+		return nil, err
+	}
+	if req.Sort, err = blockFields.ParseQuerySort(req.Query.Sort); err != nil {
+		return nil, err
+	}
+	if req.Filter, err = blockFields.ParseQueryFilter(c.Request.URL.Query()); err != nil {
+		return nil, err
+	}
+	if req.Cursor, err = utils.ParseQueryCursor(req.Sort, req.Query.Cursor); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,66 @@
+package validator
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/sentinel-official/explorer/models"
+)
+
+type UptimeWindow struct {
+	Size         int64   `json:"size"`
+	MissedBlocks int64   `json:"missed_blocks"`
+	Uptime       float64 `json:"uptime"`
+}
+
+type ResponseValidator struct {
+	*models.Validator
+	UptimeWindows []*UptimeWindow `json:"uptime_windows,omitempty"`
+}
+
+// NewResponseValidator returns the validator with the uptime of each window,
+// which only counts the heights the validator has been seen at for the windows
+// larger than its history.
+func NewResponseValidator(v *models.Validator) *ResponseValidator {
+	res := &ResponseValidator{
+		Validator: v,
+	}
+
+	for key, missed := range v.UptimeWindows {
+		size, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		blocks := size
+		if n := v.LastHeight - v.StartHeight + 1; n < blocks {
+			blocks = n
+		}
+
+		uptime := 0.0
+		if blocks > 0 {
+			uptime = 1 - float64(missed)/float64(blocks)
+		}
+
+		res.UptimeWindows = append(res.UptimeWindows, &UptimeWindow{
+			Size:         size,
+			MissedBlocks: missed,
+			Uptime:       uptime,
+		})
+	}
+
+	sort.Slice(res.UptimeWindows, func(i, j int) bool {
+		return res.UptimeWindows[i].Size < res.UptimeWindows[j].Size
+	})
+
+	return res
+}
+
+func NewResponseValidators(v []*models.Validator) []*ResponseValidator {
+	items := make([]*ResponseValidator, 0, len(v))
+	for _, item := range v {
+		items = append(items, NewResponseValidator(item))
+	}
+
+	return items
+}
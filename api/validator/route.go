@@ -0,0 +1,12 @@
+package validator
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func RegisterRoutes(router gin.IRouter, db *mongo.Database) {
+	router.GET("/validators", HandlerGetValidators(db))
+	router.GET("/validators/:addr", HandlerGetValidator(db))
+	router.GET("/validators/:addr/blocks", HandlerGetValidatorBlocks(db))
+}
@@ -4,23 +4,37 @@ import (
 	"context"
 	"flag"
 	"log"
+	"net"
 	"net/http"
 	"sort"
 	"strings"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
-	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 
+	accountapi "github.com/sentinel-official/explorer/api/account"
 	blockapi "github.com/sentinel-official/explorer/api/block"
 	depositapi "github.com/sentinel-official/explorer/api/deposit"
+	failureapi "github.com/sentinel-official/explorer/api/failure"
+	graphqlapi "github.com/sentinel-official/explorer/api/graphql"
+	grpcapi "github.com/sentinel-official/explorer/api/grpc"
+	ibcapi "github.com/sentinel-official/explorer/api/ibc"
 	nodeapi "github.com/sentinel-official/explorer/api/node"
+	planapi "github.com/sentinel-official/explorer/api/plan"
+	priceapi "github.com/sentinel-official/explorer/api/price"
+	proposalapi "github.com/sentinel-official/explorer/api/proposal"
+	providerapi "github.com/sentinel-official/explorer/api/provider"
 	sessionapi "github.com/sentinel-official/explorer/api/session"
 	statisticsapi "github.com/sentinel-official/explorer/api/statistics"
+	streamapi "github.com/sentinel-official/explorer/api/stream"
 	subscriptionapi "github.com/sentinel-official/explorer/api/subscription"
 	txapi "github.com/sentinel-official/explorer/api/tx"
+	validatorapi "github.com/sentinel-official/explorer/api/validator"
 	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/migrations"
 	"github.com/sentinel-official/explorer/utils"
 )
 
@@ -34,6 +48,7 @@ var (
 	dbUsername   string
 	dbPassword   string
 	excludeAddrs string
+	grpcAddress  string
 )
 
 func init() {
@@ -44,62 +59,12 @@ func init() {
 	flag.StringVar(&dbUsername, "db-username", "", "")
 	flag.StringVar(&dbPassword, "db-password", "", "")
 	flag.StringVar(&excludeAddrs, "exclude-addrs", "sent1c4nvz43tlw6d0c9nfu6r957y5d9pgjk5czl3n3", "")
+	flag.StringVar(&grpcAddress, "grpc-address", "", "")
 	flag.Parse()
 }
 
 func createIndexes(ctx context.Context, db *mongo.Database) error {
-	indexes := []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "status", Value: 1},
-			},
-		},
-		{
-			Keys: bson.D{
-				bson.E{Key: "status", Value: 1},
-				bson.E{Key: "register_height", Value: 1},
-			},
-		},
-	}
-
-	_, err := database.NodeIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	indexes = []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "status", Value: 1},
-			},
-		},
-		{
-			Keys: bson.D{
-				bson.E{Key: "status", Value: 1},
-				bson.E{Key: "acc_addr", Value: 1},
-			},
-		},
-		{
-			Keys: bson.D{
-				bson.E{Key: "status", Value: 1},
-				bson.E{Key: "node_addr", Value: 1},
-			},
-		},
-		{
-			Keys: bson.D{
-				bson.E{Key: "node_addr", Value: 1},
-				bson.E{Key: "status", Value: 1},
-				bson.E{Key: "acc_addr", Value: 1},
-			},
-		},
-	}
-
-	_, err = database.SessionIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return migrations.CreateIndexes(ctx, db, database.NodeCollectionName, database.SessionCollectionName)
 }
 
 func main() {
@@ -119,16 +84,48 @@ func main() {
 		log.Fatalln(err)
 	}
 
+	hub := streamapi.NewHub(db)
+	go hub.Run(context.TODO())
+
 	router := gin.Default()
 	router.Use(cors.Default())
+	router.Use(ibcapi.AnnotateDenoms(db))
 
+	accountapi.RegisterRoutes(router, db)
 	blockapi.RegisterRoutes(router, db)
 	depositapi.RegisterRoutes(router, db)
+	failureapi.RegisterRoutes(router, db)
+	graphqlapi.RegisterRoutes(router, db)
+	ibcapi.RegisterRoutes(router, db)
 	nodeapi.RegisterRoutes(router, db, excludeAddrs)
+	planapi.RegisterRoutes(router, db)
+	priceapi.RegisterRoutes(router, db)
+	proposalapi.RegisterRoutes(router, db)
+	providerapi.RegisterRoutes(router, db)
 	sessionapi.RegisterRoutes(router, db)
 	statisticsapi.RegisterRoutes(router, db, excludeAddrs)
+	streamapi.RegisterRoutes(router, hub)
 	subscriptionapi.RegisterRoutes(router, db)
 	txapi.RegisterRoutes(router, db)
+	validatorapi.RegisterRoutes(router, db)
+
+	// The gRPC server is started only when an address is given.
+	if grpcAddress != "" {
+		server := grpc.NewServer()
+		grpcapi.RegisterServices(server, db, excludeAddrs)
+		reflection.Register(server)
+
+		listener, err := net.Listen("tcp", grpcAddress)
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+		go func() {
+			if err := server.Serve(listener); err != nil {
+				log.Fatalln(err)
+			}
+		}()
+	}
 
 	if err := http.ListenAndServe(":8080", router); err != nil {
 		log.Fatalln(err)
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	tmhttp "github.com/tendermint/tendermint/rpc/client/http"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/sentinel-official/explorer/querier"
+)
+
+// Follower waits for new blocks once the indexer has caught up with the chain. It listens to the
+// NewBlock events over the websocket and falls back to polling the status endpoint whenever the
+// subscription cannot be established or stops delivering events.
+type Follower struct {
+	q      *querier.Querier
+	client *tmhttp.HTTP
+	events <-chan coretypes.ResultEvent
+}
+
+func NewFollower(q *querier.Querier) *Follower {
+	return &Follower{
+		q: q,
+	}
+}
+
+// subscribe listens to the NewBlock events of the endpoint currently used by the querier.
+func (f *Follower) subscribe() error {
+	client := f.q.Client()
+	if !client.IsRunning() {
+		if err := client.Start(); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), followTimeout)
+	defer cancel()
+
+	events, err := client.Subscribe(ctx, appName, tmtypes.EventQueryNewBlock.String(), 64)
+	if err != nil {
+		return err
+	}
+
+	f.client, f.events = client, events
+	return nil
+}
+
+func (f *Follower) unsubscribe() {
+	ctx, cancel := context.WithTimeout(context.TODO(), followTimeout)
+	defer cancel()
+
+	if err := f.client.UnsubscribeAll(ctx, appName); err != nil {
+		log.Println("UnsubscribeAll", err)
+	}
+
+	f.client, f.events = nil, nil
+}
+
+func (f *Follower) latestHeight() (int64, error) {
+	ctx, cancel := context.WithTimeout(context.TODO(), followTimeout)
+	defer cancel()
+
+	status, err := f.q.QueryStatus(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// Wait blocks until the chain has committed a block at the given height and returns the latest
+// known height of the chain.
+func (f *Follower) Wait(height int64) int64 {
+	for {
+		latestHeight, err := f.latestHeight()
+		if err != nil {
+			log.Println("Status", err)
+			time.Sleep(pollInterval)
+			continue
+		}
+		if latestHeight >= height {
+			return latestHeight
+		}
+
+		// The querier may have switched to another endpoint, whose websocket is
+		// subscribed to instead.
+		if f.events != nil && f.client != f.q.Client() {
+			log.Println("Resubscribing", f.q.Client().Remote())
+			f.unsubscribe()
+		}
+
+		if f.events == nil {
+			if err := f.subscribe(); err != nil {
+				log.Println("Subscribe", err)
+				time.Sleep(pollInterval)
+			}
+
+			// The status is queried again, since a block may have been committed
+			// before the subscription was established.
+			continue
+		}
+
+		select {
+		case event, ok := <-f.events:
+			if !ok {
+				log.Println("Subscription closed")
+				f.client, f.events = nil, nil
+				continue
+			}
+
+			data, ok := event.Data.(tmtypes.EventDataNewBlock)
+			if !ok || data.Block == nil {
+				continue
+			}
+
+			log.Println("NewBlock", data.Block.Height)
+			if data.Block.Height >= height {
+				return data.Block.Height
+			}
+		case <-time.After(followTimeout):
+			log.Println("Subscription timeout")
+			f.unsubscribe()
+		}
+	}
+}
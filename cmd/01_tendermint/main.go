@@ -5,9 +5,11 @@ import (
 	"flag"
 	"log"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/sentinel-official/hub/app"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -15,6 +17,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
 
 	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/migrations"
 	"github.com/sentinel-official/explorer/models"
 	"github.com/sentinel-official/explorer/querier"
 	"github.com/sentinel-official/explorer/types"
@@ -26,13 +29,21 @@ const (
 )
 
 var (
-	fromHeight int64
-	toHeight   int64
-	rpcAddress string
-	dbAddress  string
-	dbName     string
-	dbUsername string
-	dbPassword string
+	fromHeight      int64
+	toHeight        int64
+	rpcAddress      string
+	dbAddress       string
+	dbName          string
+	dbUsername      string
+	dbPassword      string
+	follow          bool
+	followTimeout   time.Duration
+	pollInterval    time.Duration
+	prefetchWorkers int
+	prefetchSize    int
+	rpcMaxRounds    int
+	rpcMinBackoff   time.Duration
+	rpcMaxBackoff   time.Duration
 )
 
 func init() {
@@ -45,73 +56,23 @@ func init() {
 	flag.StringVar(&dbName, "db-name", "sentinelhub-2", "")
 	flag.StringVar(&dbUsername, "db-username", "", "")
 	flag.StringVar(&dbPassword, "db-password", "", "")
+	flag.BoolVar(&follow, "follow", true, "")
+	flag.DurationVar(&followTimeout, "follow-timeout", 30*time.Second, "")
+	flag.DurationVar(&pollInterval, "poll-interval", 5*time.Second, "")
+	flag.IntVar(&prefetchWorkers, "prefetch-workers", 8, "")
+	flag.IntVar(&prefetchSize, "prefetch-size", 64, "")
+	flag.IntVar(&rpcMaxRounds, "rpc-max-rounds", 3, "")
+	flag.DurationVar(&rpcMinBackoff, "rpc-min-backoff", time.Second, "")
+	flag.DurationVar(&rpcMaxBackoff, "rpc-max-backoff", 30*time.Second, "")
 	flag.Parse()
 }
 
 func createIndexes(ctx context.Context, db *mongo.Database) error {
-	indexes := []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "app_name", Value: 1},
-			},
-			Options: options.Index().
-				SetUnique(true),
-		},
-	}
-
-	_, err := database.SyncStatusIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	indexes = []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "height", Value: 1},
-			},
-			Options: options.Index().
-				SetUnique(true),
-		},
-	}
-
-	_, err = database.BlockIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	indexes = []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "height", Value: 1},
-				bson.E{Key: "result.code", Value: 1},
-			},
-			Options: options.Index().
-				SetPartialFilterExpression(
-					bson.M{
-						"result.code": 0,
-					},
-				),
-		},
-	}
-
-	_, err = database.TxIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return migrations.CreateIndexes(ctx, db, database.SyncStatusCollectionName, database.BlockCollectionName, database.TxCollectionName)
 }
 
-func run(db *mongo.Database, q *querier.Querier, height int64) (ops []types.DatabaseOperation, err error) {
-	qBlock, err := q.QueryBlock(context.TODO(), height)
-	if err != nil {
-		return nil, err
-	}
-
-	qBlockResults, err := q.QueryBlockResults(context.TODO(), height)
-	if err != nil {
-		return nil, err
-	}
+func run(db *mongo.Database, qBlock *coretypes.ResultBlock, qBlockResults *coretypes.ResultBlockResults) (ops []types.DatabaseOperation, err error) {
+	height := qBlock.Block.Height
 
 	ops = append(ops, func(ctx mongo.SessionContext) error {
 		filter := bson.M{
@@ -188,11 +149,18 @@ func run(db *mongo.Database, q *querier.Querier, height int64) (ops []types.Data
 func main() {
 	encCfg := app.DefaultEncodingConfig()
 
-	q, err := querier.NewQuerier(encCfg.InterfaceRegistry, rpcAddress, "/websocket")
+	if rpcMaxRounds < 1 {
+		log.Fatalln("rpc-max-rounds must be greater than zero")
+	}
+
+	q, err := querier.NewQuerier(encCfg.InterfaceRegistry, strings.Split(rpcAddress, ","), "/websocket")
 	if err != nil {
 		log.Fatalln(err)
 	}
 
+	q = q.WithMaxRounds(rpcMaxRounds).
+		WithBackoff(rpcMinBackoff, rpcMaxBackoff)
+
 	db, err := utils.PrepareDatabase(context.TODO(), appName, dbUsername, dbPassword, dbAddress, dbName)
 	if err != nil {
 		log.Fatalln(err)
@@ -222,12 +190,44 @@ func main() {
 		}
 	}
 
-	height := dSyncStatus.Height + 1
+	var (
+		follower     = NewFollower(q)
+		prefetcher   = NewPrefetcher(q, prefetchWorkers, prefetchSize)
+		height       = dSyncStatus.Height + 1
+		latestHeight = int64(0)
+	)
+
 	for height < toHeight {
+		if height > latestHeight {
+			if follow {
+				latestHeight = follower.Wait(height)
+			} else {
+				// Without following, the heights are prefetched only up to the latest
+				// height of the chain, which is queried again once it is reached.
+				latestHeight, err = follower.latestHeight()
+				if err != nil {
+					log.Fatalln(err)
+				}
+				if height > latestHeight {
+					log.Fatalf("height %d is above the latest height %d\n", height, latestHeight)
+				}
+			}
+		}
+
 		now := time.Now()
 		log.Println("Height", height)
 
-		ops, err := run(db, q, height)
+		maxHeight := toHeight - 1
+		if latestHeight < maxHeight {
+			maxHeight = latestHeight
+		}
+
+		qBlock, qBlockResults, err := prefetcher.Fetch(height, maxHeight)
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+		ops, err := run(db, qBlock, qBlockResults)
 		if err != nil {
 			log.Fatalln(err)
 		}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/sentinel-official/explorer/querier"
+)
+
+type prefetchResult struct {
+	Block        *coretypes.ResultBlock
+	BlockResults *coretypes.ResultBlockResults
+	Err          error
+}
+
+// Prefetcher queries the blocks and the block results ahead of the write cursor with a pool of
+// workers. The results are handed out strictly in the order of the heights.
+type Prefetcher struct {
+	q       *querier.Querier
+	sem     chan struct{}
+	size    int
+	head    int64
+	next    int64
+	results []chan *prefetchResult
+}
+
+func NewPrefetcher(q *querier.Querier, workers, size int) *Prefetcher {
+	if workers < 1 {
+		workers = 1
+	}
+	if size < workers {
+		size = workers
+	}
+
+	return &Prefetcher{
+		q:    q,
+		sem:  make(chan struct{}, workers),
+		size: size,
+	}
+}
+
+func (p *Prefetcher) schedule(height int64) chan *prefetchResult {
+	result := make(chan *prefetchResult, 1)
+	go func() {
+		p.sem <- struct{}{}
+		defer func() { <-p.sem }()
+
+		qBlock, err := p.q.QueryBlock(context.TODO(), height)
+		if err != nil {
+			result <- &prefetchResult{Err: err}
+			return
+		}
+
+		qBlockResults, err := p.q.QueryBlockResults(context.TODO(), height)
+		if err != nil {
+			result <- &prefetchResult{Err: err}
+			return
+		}
+
+		result <- &prefetchResult{
+			Block:        qBlock,
+			BlockResults: qBlockResults,
+		}
+	}()
+
+	return result
+}
+
+// Fetch returns the block and the block results at the given height and schedules the following
+// heights, up to maxHeight, to be fetched in the background.
+func (p *Prefetcher) Fetch(height, maxHeight int64) (*coretypes.ResultBlock, *coretypes.ResultBlockResults, error) {
+	if height != p.head || len(p.results) == 0 {
+		p.head, p.next, p.results = height, height, nil
+	}
+
+	for p.next <= maxHeight && len(p.results) < p.size {
+		p.results = append(p.results, p.schedule(p.next))
+		p.next++
+	}
+	if len(p.results) == 0 {
+		p.results = append(p.results, p.schedule(p.next))
+		p.next++
+	}
+
+	result := <-p.results[0]
+	p.head, p.results = p.head+1, p.results[1:]
+
+	return result.Block, result.BlockResults, result.Err
+}
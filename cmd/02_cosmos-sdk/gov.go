@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/querier"
+	"github.com/sentinel-official/explorer/types"
+)
+
+// ensureProposal returns the operations creating the proposal from the state
+// at the previous height, when it was submitted before the first indexed
+// height. Without an RPC address such a proposal cannot be created, so it is
+// logged and false is returned for its updates to be skipped. The known map
+// holds the proposals found or created earlier in the block.
+func ensureProposal(db *mongo.Database, q *querier.Querier, known map[uint64]bool, id uint64, height int64) (bool, []types.DatabaseOperation, error) {
+	if known[id] {
+		return true, nil, nil
+	}
+
+	filter := bson.M{
+		"id": id,
+	}
+	projection := bson.M{
+		"_id": 1,
+	}
+
+	dProposal, err := database.ProposalFindOne(context.TODO(), db, filter, options.FindOne().SetProjection(projection))
+	if err != nil {
+		return false, nil, err
+	}
+	if dProposal != nil {
+		known[id] = true
+		return true, nil, nil
+	}
+
+	if q == nil {
+		log.Println("ProposalNotExist", id, "Height", height)
+		return false, nil, nil
+	}
+
+	proposal, err := q.QueryProposal(querier.ContextWithHeight(context.TODO(), height-1), id)
+	if err != nil {
+		return false, nil, err
+	}
+
+	item, err := models.NewProposalFromGovProposal(proposal)
+	if err != nil {
+		return false, nil, err
+	}
+
+	item.StatusHeight = height - 1
+	known[id] = true
+
+	log.Println("ProposalFromState", id, "Height", height-1)
+	return true, []types.DatabaseOperation{operations.NewProposalCreate(db, item)}, nil
+}
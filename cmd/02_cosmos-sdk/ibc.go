@@ -0,0 +1,185 @@
+package main
+
+import (
+	"fmt"
+
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/types"
+	ibctypes "github.com/sentinel-official/explorer/types/ibc"
+)
+
+// handleMsgTransfer records the outgoing transfer of the packet sent by the message. The packet
+// data of the send_packet event is used, since it carries the full trace path of the denom.
+func handleMsgTransfer(db *mongo.Database, dBlock *models.Block, dTx *models.Tx, eIndex int) (int, []types.DatabaseOperation, error) {
+	eIndex, event, err := ibctypes.NewEventSendPacketFromEvents(dTx.Result.Events, eIndex+1)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	dIBCTransfer := models.IBCTransfer{
+		Direction:  models.IBCTransferDirectionOutgoing,
+		SrcPort:    event.SourcePort,
+		SrcChannel: event.SourceChannel,
+		DstPort:    event.DestinationPort,
+		DstChannel: event.DestinationChannel,
+		Sequence:   event.Sequence,
+		Sender:     event.Data.Sender,
+		Receiver:   event.Data.Receiver,
+		Denom:      event.Data.Denom,
+		Amount:     event.Data.Amount,
+		Memo:       event.Data.Memo,
+		Height:     dBlock.Height,
+		Timestamp:  dBlock.Time,
+		TxHash:     dTx.Hash,
+		Status:     models.IBCTransferStatusPending,
+	}
+
+	return eIndex, []types.DatabaseOperation{
+		operations.NewIBCTransferCreate(db, &dIBCTransfer),
+	}, nil
+}
+
+// handleMsgRecvPacket records the incoming transfer of the packet, along with the denom trace of
+// the received coin unless the coin returns to this chain.
+func handleMsgRecvPacket(db *mongo.Database, dBlock *models.Block, dTx *models.Tx, v bson.M) (ops []types.DatabaseOperation, err error) {
+	msg, err := ibctypes.NewMsgRecvPacket(v)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Packet.DestinationPort != transfertypes.PortID {
+		return nil, nil
+	}
+	if ibctypes.FindPacketEvent(dTx.Result.Events, "recv_packet", msg.Packet) == nil {
+		return nil, nil
+	}
+
+	data, err := ibctypes.NewFungibleTokenPacketData(msg.Packet.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	event := ibctypes.FindPacketEvent(dTx.Result.Events, "write_acknowledgement", msg.Packet)
+	if event == nil {
+		return nil, fmt.Errorf("acknowledgement of packet %d does not exist", msg.Packet.Sequence)
+	}
+
+	ack, err := ibctypes.NewAcknowledgement([]byte(event.Attributes["packet_ack"]))
+	if err != nil {
+		return nil, err
+	}
+
+	status := models.IBCTransferStatusReceived
+	if !ack.Success() {
+		status = models.IBCTransferStatusFailed
+	}
+
+	dIBCTransfer := models.IBCTransfer{
+		Direction:       models.IBCTransferDirectionIncoming,
+		SrcPort:         msg.Packet.SourcePort,
+		SrcChannel:      msg.Packet.SourceChannel,
+		DstPort:         msg.Packet.DestinationPort,
+		DstChannel:      msg.Packet.DestinationChannel,
+		Sequence:        msg.Packet.Sequence,
+		Sender:          data.Sender,
+		Receiver:        data.Receiver,
+		Denom:           data.Denom,
+		Amount:          data.Amount,
+		Memo:            data.Memo,
+		Height:          dBlock.Height,
+		Timestamp:       dBlock.Time,
+		TxHash:          dTx.Hash,
+		Status:          status,
+		StatusError:     ack.Error,
+		StatusHeight:    dBlock.Height,
+		StatusTimestamp: dBlock.Time,
+		StatusTxHash:    dTx.Hash,
+	}
+
+	ops = append(
+		ops,
+		operations.NewIBCTransferCreate(db, &dIBCTransfer),
+	)
+
+	if !ack.Success() {
+		return ops, nil
+	}
+
+	ops = append(
+		ops,
+		operations.NewAccountCreate(db, data.Receiver, dBlock.Height, dBlock.Time, dTx.Hash),
+	)
+
+	if !transfertypes.ReceiverChainIsSource(msg.Packet.SourcePort, msg.Packet.SourceChannel, data.Denom) {
+		trace := transfertypes.ParseDenomTrace(
+			transfertypes.GetPrefixedDenom(msg.Packet.DestinationPort, msg.Packet.DestinationChannel, data.Denom),
+		)
+
+		dDenomTrace := models.DenomTrace{
+			Hash:      trace.Hash().String(),
+			Path:      trace.Path,
+			BaseDenom: trace.BaseDenom,
+		}
+
+		ops = append(
+			ops,
+			operations.NewDenomTraceUpsert(db, &dDenomTrace),
+		)
+	}
+
+	return ops, nil
+}
+
+// handleMsgAcknowledgement moves the outgoing transfer of the packet to either acknowledged or
+// failed, depending on the acknowledgement written by the counterparty.
+func handleMsgAcknowledgement(db *mongo.Database, dBlock *models.Block, dTx *models.Tx, v bson.M) ([]types.DatabaseOperation, error) {
+	msg, err := ibctypes.NewMsgAcknowledgement(v)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Packet.SourcePort != transfertypes.PortID {
+		return nil, nil
+	}
+	if ibctypes.FindPacketEvent(dTx.Result.Events, "acknowledge_packet", msg.Packet) == nil {
+		return nil, nil
+	}
+
+	status := models.IBCTransferStatusAcknowledged
+	if !msg.Acknowledgement.Success() {
+		status = models.IBCTransferStatusFailed
+	}
+
+	return []types.DatabaseOperation{
+		operations.NewIBCTransferUpdateStatus(
+			db, msg.Packet.SourcePort, msg.Packet.SourceChannel, msg.Packet.Sequence,
+			status, msg.Acknowledgement.Error, dBlock.Height, dBlock.Time, dTx.Hash,
+		),
+	}, nil
+}
+
+// handleMsgTimeout moves the outgoing transfer of the packet to timed out, for both MsgTimeout and
+// MsgTimeoutOnClose.
+func handleMsgTimeout(db *mongo.Database, dBlock *models.Block, dTx *models.Tx, v bson.M) ([]types.DatabaseOperation, error) {
+	msg, err := ibctypes.NewMsgTimeout(v)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Packet.SourcePort != transfertypes.PortID {
+		return nil, nil
+	}
+	if ibctypes.FindPacketEvent(dTx.Result.Events, "timeout_packet", msg.Packet) == nil &&
+		ibctypes.FindPacketEvent(dTx.Result.Events, "timeout_on_close_packet", msg.Packet) == nil {
+		return nil, nil
+	}
+
+	return []types.DatabaseOperation{
+		operations.NewIBCTransferUpdateStatus(
+			db, msg.Packet.SourcePort, msg.Packet.SourceChannel, msg.Packet.Sequence,
+			models.IBCTransferStatusTimedOut, "", dBlock.Height, dBlock.Time, dTx.Hash,
+		),
+	}, nil
+}
@@ -1,7 +1,741 @@
 package main
 
-const appName = "02_cosmos-sdk"
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/sentinel-official/hub/app"
+	hubtypes "github.com/sentinel-official/hub/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/migrations"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/querier"
+	"github.com/sentinel-official/explorer/types"
+	banktypes "github.com/sentinel-official/explorer/types/bank"
+	distributiontypes "github.com/sentinel-official/explorer/types/distribution"
+	govtypes "github.com/sentinel-official/explorer/types/gov"
+	stakingtypes "github.com/sentinel-official/explorer/types/staking"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	appName = "02_cosmos-sdk"
+)
+
+var (
+	fromHeight int64
+	toHeight   int64
+	rpcAddress string
+	dbAddress  string
+	dbName     string
+	dbUsername string
+	dbPassword string
+)
+
+func init() {
+	log.SetFlags(0)
+
+	flag.Int64Var(&fromHeight, "from-height", 12_310_005, "")
+	flag.Int64Var(&toHeight, "to-height", math.MaxInt64, "")
+	flag.StringVar(&rpcAddress, "rpc-address", "", "")
+	flag.StringVar(&dbAddress, "db-address", "mongodb://127.0.0.1:27017", "")
+	flag.StringVar(&dbName, "db-name", "sentinelhub-2", "")
+	flag.StringVar(&dbUsername, "db-username", "", "")
+	flag.StringVar(&dbPassword, "db-password", "", "")
+	flag.Parse()
+}
+
+func createIndexes(ctx context.Context, db *mongo.Database) error {
+	return migrations.CreateIndexes(
+		ctx, db,
+		database.SyncStatusCollectionName,
+		database.BlockCollectionName,
+		database.TxCollectionName,
+		database.AccountCollectionName,
+		database.TransferCollectionName,
+		database.DelegationCollectionName,
+		database.UnbondingCollectionName,
+		database.RedelegationCollectionName,
+		database.RewardWithdrawalCollectionName,
+		database.ProposalCollectionName,
+		database.ProposalDepositCollectionName,
+		database.ProposalVoteCollectionName,
+		database.IBCTransferCollectionName,
+		database.DenomTraceCollectionName,
+	)
+}
+
+// finalTallyResult returns the tally of the proposal as stored once its voting
+// period ended at the height, or nil when no RPC address is given.
+func finalTallyResult(q *querier.Querier, id uint64, height int64) (*models.ProposalTallyResult, error) {
+	if q == nil {
+		return nil, nil
+	}
+
+	proposal, err := q.QueryProposal(querier.ContextWithHeight(context.TODO(), height), id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ProposalTallyResult{
+		Yes:        proposal.FinalTallyResult.Yes.String(),
+		Abstain:    proposal.FinalTallyResult.Abstain.String(),
+		No:         proposal.FinalTallyResult.No.String(),
+		NoWithVeto: proposal.FinalTallyResult.NoWithVeto.String(),
+	}, nil
+}
+
+func run(db *mongo.Database, q *querier.Querier, height int64) (ops []types.DatabaseOperation, err error) {
+	filter := bson.M{
+		"height": height,
+	}
+	projection := bson.M{
+		"end_block_events": 1,
+		"height":           1,
+		"time":             1,
+	}
+
+	dBlock, err := database.BlockFindOne(context.TODO(), db, filter, options.FindOne().SetProjection(projection))
+	if err != nil {
+		return nil, err
+	}
+	if dBlock == nil {
+		return nil, fmt.Errorf("block %d does not exist", height)
+	}
+
+	proposals := make(map[uint64]bool)
+
+	filter = bson.M{
+		"height":      height,
+		"result.code": 0,
+	}
+	projection = bson.M{
+		"hash":                 1,
+		"messages":             1,
+		"result.events":        1,
+		"signer_infos.address": 1,
+	}
+
+	dTxs, err := database.TxFind(context.TODO(), db, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, err
+	}
+
+	log.Println("TxsLen", len(dTxs))
+	for tIndex := 0; tIndex < len(dTxs); tIndex++ {
+		dTxs[tIndex].Messages = dTxs[tIndex].Messages.WithAuthzMsgExecMessages()
+		log.Println("TxHash", dTxs[tIndex].Hash)
+		log.Println("MessagesLen", tIndex, len(dTxs[tIndex].Messages))
+
+		for sIndex := 0; sIndex < len(dTxs[tIndex].SignerInfos); sIndex++ {
+			ops = append(
+				ops,
+				operations.NewAccountCreate(db, dTxs[tIndex].SignerInfos[sIndex].Address, dBlock.Height, dBlock.Time, dTxs[tIndex].Hash),
+			)
+		}
+
+		for eIndex, mIndex := -1, 0; mIndex < len(dTxs[tIndex].Messages); mIndex++ {
+			log.Println("Type", dTxs[tIndex].Messages[mIndex].Type)
+			switch dTxs[tIndex].Messages[mIndex].Type {
+			case "/cosmos.bank.v1beta1.MsgSend":
+				msg, err := banktypes.NewMsgSend(dTxs[tIndex].Messages[mIndex].Data)
+				if err != nil {
+					return nil, err
+				}
+
+				dTransfer := models.Transfer{
+					FromAddr:  msg.FromAddress,
+					ToAddr:    msg.ToAddress,
+					Coins:     msg.Amount,
+					Height:    dBlock.Height,
+					Timestamp: dBlock.Time,
+					TxHash:    dTxs[tIndex].Hash,
+				}
+
+				ops = append(
+					ops,
+					operations.NewAccountCreate(db, msg.ToAddress, dBlock.Height, dBlock.Time, dTxs[tIndex].Hash),
+					operations.NewTransferCreate(db, &dTransfer),
+				)
+			case "/cosmos.bank.v1beta1.MsgMultiSend":
+				msg, err := banktypes.NewMsgMultiSend(dTxs[tIndex].Messages[mIndex].Data)
+				if err != nil {
+					return nil, err
+				}
+
+				// A multi-send can not be split into pairs unless there is exactly one input,
+				// so with several inputs each side is recorded with an empty counterparty.
+				fromAddr := ""
+				if len(msg.Inputs) == 1 {
+					fromAddr = msg.Inputs[0].Address
+				} else {
+					for _, input := range msg.Inputs {
+						dTransfer := models.Transfer{
+							FromAddr:  input.Address,
+							ToAddr:    "",
+							Coins:     input.Coins,
+							Height:    dBlock.Height,
+							Timestamp: dBlock.Time,
+							TxHash:    dTxs[tIndex].Hash,
+						}
+
+						ops = append(
+							ops,
+							operations.NewTransferCreate(db, &dTransfer),
+						)
+					}
+				}
+
+				for _, output := range msg.Outputs {
+					dTransfer := models.Transfer{
+						FromAddr:  fromAddr,
+						ToAddr:    output.Address,
+						Coins:     output.Coins,
+						Height:    dBlock.Height,
+						Timestamp: dBlock.Time,
+						TxHash:    dTxs[tIndex].Hash,
+					}
+
+					ops = append(
+						ops,
+						operations.NewAccountCreate(db, output.Address, dBlock.Height, dBlock.Time, dTxs[tIndex].Hash),
+						operations.NewTransferCreate(db, &dTransfer),
+					)
+				}
+			case "/cosmos.staking.v1beta1.MsgCreateValidator":
+				msg, err := stakingtypes.NewMsgCreateValidator(dTxs[tIndex].Messages[mIndex].Data)
+				if err != nil {
+					return nil, err
+				}
+
+				op, err := newDelegationOperation(db, q, dBlock, dTxs[tIndex].Hash, msg.DelegatorAddress, msg.ValidatorAddress, msg.Value, true)
+				if err != nil {
+					return nil, err
+				}
+
+				ops = append(ops, op)
+			case "/cosmos.staking.v1beta1.MsgDelegate":
+				msg, err := stakingtypes.NewMsgDelegate(dTxs[tIndex].Messages[mIndex].Data)
+				if err != nil {
+					return nil, err
+				}
+
+				// The rewards of an existing delegation are withdrawn before it changes.
+				from := eIndex + 1
+				if eIndex, _, err = dTxs[tIndex].Result.Events.Get("delegate", from); err != nil {
+					return nil, err
+				}
+
+				wOps, err := newAutoRewardWithdrawalOperations(db, dBlock, dTxs[tIndex], msg.DelegatorAddress, from, eIndex)
+				if err != nil {
+					return nil, err
+				}
+
+				op, err := newDelegationOperation(db, q, dBlock, dTxs[tIndex].Hash, msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount, true)
+				if err != nil {
+					return nil, err
+				}
+
+				ops = append(ops, wOps...)
+				ops = append(ops, op)
+			case "/cosmos.staking.v1beta1.MsgUndelegate":
+				msg, err := stakingtypes.NewMsgUndelegate(dTxs[tIndex].Messages[mIndex].Data)
+				if err != nil {
+					return nil, err
+				}
+
+				var (
+					eventUnbond *stakingtypes.EventUnbond
+					from        = eIndex + 1
+				)
+
+				eIndex, eventUnbond, err = stakingtypes.NewEventUnbondFromEvents(dTxs[tIndex].Result.Events, from)
+				if err != nil {
+					return nil, err
+				}
+
+				wOps, err := newAutoRewardWithdrawalOperations(db, dBlock, dTxs[tIndex], msg.DelegatorAddress, from, eIndex)
+				if err != nil {
+					return nil, err
+				}
+
+				op, err := newDelegationOperation(db, q, dBlock, dTxs[tIndex].Hash, msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount, false)
+				if err != nil {
+					return nil, err
+				}
+
+				dUnbonding := models.Unbonding{
+					AccAddr:        msg.DelegatorAddress,
+					ValAddr:        msg.ValidatorAddress,
+					Amount:         eventUnbond.Amount,
+					CompletionTime: eventUnbond.CompletionTime,
+					StartHeight:    dBlock.Height,
+					StartTimestamp: dBlock.Time,
+					StartTxHash:    dTxs[tIndex].Hash,
+					EndHeight:      0,
+					EndTimestamp:   time.Time{},
+					Status:         hubtypes.StatusActive.String(),
+				}
+
+				ops = append(ops, wOps...)
+				ops = append(
+					ops,
+					op,
+					operations.NewUnbondingCreate(db, &dUnbonding),
+				)
+			case "/cosmos.staking.v1beta1.MsgBeginRedelegate":
+				msg, err := stakingtypes.NewMsgBeginRedelegate(dTxs[tIndex].Messages[mIndex].Data)
+				if err != nil {
+					return nil, err
+				}
+
+				var (
+					eventRedelegate *stakingtypes.EventRedelegate
+					from            = eIndex + 1
+				)
+
+				eIndex, eventRedelegate, err = stakingtypes.NewEventRedelegateFromEvents(dTxs[tIndex].Result.Events, from)
+				if err != nil {
+					return nil, err
+				}
+
+				wOps, err := newAutoRewardWithdrawalOperations(db, dBlock, dTxs[tIndex], msg.DelegatorAddress, from, eIndex)
+				if err != nil {
+					return nil, err
+				}
+
+				srcOp, err := newDelegationOperation(db, q, dBlock, dTxs[tIndex].Hash, msg.DelegatorAddress, msg.ValidatorSrcAddress, msg.Amount, false)
+				if err != nil {
+					return nil, err
+				}
+
+				dstOp, err := newDelegationOperation(db, q, dBlock, dTxs[tIndex].Hash, msg.DelegatorAddress, msg.ValidatorDstAddress, msg.Amount, true)
+				if err != nil {
+					return nil, err
+				}
+
+				dRedelegation := models.Redelegation{
+					AccAddr:        msg.DelegatorAddress,
+					SrcValAddr:     msg.ValidatorSrcAddress,
+					DstValAddr:     msg.ValidatorDstAddress,
+					Amount:         eventRedelegate.Amount,
+					CompletionTime: eventRedelegate.CompletionTime,
+					StartHeight:    dBlock.Height,
+					StartTimestamp: dBlock.Time,
+					StartTxHash:    dTxs[tIndex].Hash,
+					EndHeight:      0,
+					EndTimestamp:   time.Time{},
+					Status:         hubtypes.StatusActive.String(),
+				}
+
+				ops = append(ops, wOps...)
+				ops = append(
+					ops,
+					srcOp,
+					dstOp,
+					operations.NewRedelegationCreate(db, &dRedelegation),
+				)
+			case "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward":
+				msg, err := distributiontypes.NewMsgWithdrawDelegatorReward(dTxs[tIndex].Messages[mIndex].Data)
+				if err != nil {
+					return nil, err
+				}
+
+				var (
+					eventWithdrawRewards *distributiontypes.EventWithdrawRewards
+				)
+
+				eIndex, eventWithdrawRewards, err = distributiontypes.NewEventWithdrawRewardsFromEvents(dTxs[tIndex].Result.Events, eIndex+1)
+				if err != nil {
+					return nil, err
+				}
+
+				dRewardWithdrawal := models.RewardWithdrawal{
+					AccAddr:   msg.DelegatorAddress,
+					ValAddr:   msg.ValidatorAddress,
+					Coins:     eventWithdrawRewards.Amount,
+					Height:    dBlock.Height,
+					Timestamp: dBlock.Time,
+					TxHash:    dTxs[tIndex].Hash,
+				}
+
+				ops = append(
+					ops,
+					operations.NewRewardWithdrawalCreate(db, &dRewardWithdrawal),
+				)
+			case "/cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission":
+				msg, err := distributiontypes.NewMsgWithdrawValidatorCommission(dTxs[tIndex].Messages[mIndex].Data)
+				if err != nil {
+					return nil, err
+				}
+
+				var (
+					eventWithdrawCommission *distributiontypes.EventWithdrawCommission
+				)
+
+				eIndex, eventWithdrawCommission, err = distributiontypes.NewEventWithdrawCommissionFromEvents(dTxs[tIndex].Result.Events, eIndex+1)
+				if err != nil {
+					return nil, err
+				}
+
+				accAddr, err := accAddrFromValAddr(msg.ValidatorAddress)
+				if err != nil {
+					return nil, err
+				}
+
+				dRewardWithdrawal := models.RewardWithdrawal{
+					AccAddr:    accAddr,
+					ValAddr:    msg.ValidatorAddress,
+					Coins:      eventWithdrawCommission.Amount,
+					Commission: true,
+					Height:     dBlock.Height,
+					Timestamp:  dBlock.Time,
+					TxHash:     dTxs[tIndex].Hash,
+				}
+
+				ops = append(
+					ops,
+					operations.NewRewardWithdrawalCreate(db, &dRewardWithdrawal),
+				)
+			case "/cosmos.gov.v1beta1.MsgSubmitProposal":
+				msg, err := govtypes.NewMsgSubmitProposal(dTxs[tIndex].Messages[mIndex].Data)
+				if err != nil {
+					return nil, err
+				}
+
+				var (
+					eventSubmitProposal *govtypes.EventSubmitProposal
+				)
+
+				eIndex, eventSubmitProposal, err = govtypes.NewEventSubmitProposalFromEvents(dTxs[tIndex].Result.Events, eIndex+1)
+				if err != nil {
+					return nil, err
+				}
+
+				dProposal := models.Proposal{
+					ID:              eventSubmitProposal.ProposalID,
+					Type:            msg.ContentType,
+					Title:           msg.Title,
+					Description:     msg.Description,
+					Content:         msg.Content,
+					Proposer:        msg.Proposer,
+					TotalDeposit:    msg.InitialDeposit,
+					SubmitHeight:    dBlock.Height,
+					SubmitTimestamp: dBlock.Time,
+					SubmitTxHash:    dTxs[tIndex].Hash,
+					Status:          govtypes.StatusDepositPeriod,
+					StatusHeight:    dBlock.Height,
+					StatusTimestamp: dBlock.Time,
+				}
+
+				proposals[dProposal.ID] = true
+				ops = append(
+					ops,
+					operations.NewProposalCreate(db, &dProposal),
+				)
+
+				if len(msg.InitialDeposit) > 0 {
+					dProposalDeposit := models.ProposalDeposit{
+						ProposalID: dProposal.ID,
+						Depositor:  msg.Proposer,
+						Coins:      msg.InitialDeposit,
+						Height:     dBlock.Height,
+						Timestamp:  dBlock.Time,
+						TxHash:     dTxs[tIndex].Hash,
+					}
+
+					ops = append(
+						ops,
+						operations.NewProposalDepositCreate(db, &dProposalDeposit),
+					)
+				}
+				if govtypes.IsVotingPeriodStarted(dTxs[tIndex].Result.Events, dProposal.ID) {
+					ops = append(
+						ops,
+						operations.NewProposalUpdateVotingStart(db, dProposal.ID, dBlock.Height, dBlock.Time, dTxs[tIndex].Hash),
+					)
+				}
+			case "/cosmos.gov.v1beta1.MsgDeposit":
+				msg, err := govtypes.NewMsgDeposit(dTxs[tIndex].Messages[mIndex].Data)
+				if err != nil {
+					return nil, err
+				}
+
+				dProposalDeposit := models.ProposalDeposit{
+					ProposalID: msg.ProposalID,
+					Depositor:  msg.Depositor,
+					Coins:      msg.Amount,
+					Height:     dBlock.Height,
+					Timestamp:  dBlock.Time,
+					TxHash:     dTxs[tIndex].Hash,
+				}
+
+				ok, pOps, err := ensureProposal(db, q, proposals, msg.ProposalID, dBlock.Height)
+				if err != nil {
+					return nil, err
+				}
+
+				ops = append(ops, pOps...)
+				if ok {
+					ops = append(
+						ops,
+						operations.NewProposalDepositAdd(db, msg.ProposalID, msg.Amount),
+					)
+				}
+
+				ops = append(
+					ops,
+					operations.NewProposalDepositCreate(db, &dProposalDeposit),
+				)
+
+				if ok && govtypes.IsVotingPeriodStarted(dTxs[tIndex].Result.Events, msg.ProposalID) {
+					ops = append(
+						ops,
+						operations.NewProposalUpdateVotingStart(db, msg.ProposalID, dBlock.Height, dBlock.Time, dTxs[tIndex].Hash),
+					)
+				}
+			case "/cosmos.gov.v1beta1.MsgVote", "/cosmos.gov.v1beta1.MsgVoteWeighted":
+				newMsg := govtypes.NewMsgVote
+				if dTxs[tIndex].Messages[mIndex].Type == "/cosmos.gov.v1beta1.MsgVoteWeighted" {
+					newMsg = govtypes.NewMsgVoteWeighted
+				}
+
+				msg, err := newMsg(dTxs[tIndex].Messages[mIndex].Data)
+				if err != nil {
+					return nil, err
+				}
+
+				dProposalVote := models.ProposalVote{
+					ProposalID: msg.ProposalID,
+					Voter:      msg.Voter,
+					Options:    msg.Options,
+					Height:     dBlock.Height,
+					Timestamp:  dBlock.Time,
+					TxHash:     dTxs[tIndex].Hash,
+				}
+
+				ops = append(
+					ops,
+					operations.NewProposalVoteUpsert(db, &dProposalVote),
+				)
+			case "/ibc.applications.transfer.v1.MsgTransfer":
+				var mOps []types.DatabaseOperation
+
+				eIndex, mOps, err = handleMsgTransfer(db, dBlock, dTxs[tIndex], eIndex)
+				if err != nil {
+					return nil, err
+				}
+
+				ops = append(ops, mOps...)
+			case "/ibc.core.channel.v1.MsgRecvPacket":
+				mOps, err := handleMsgRecvPacket(db, dBlock, dTxs[tIndex], dTxs[tIndex].Messages[mIndex].Data)
+				if err != nil {
+					return nil, err
+				}
+
+				ops = append(ops, mOps...)
+			case "/ibc.core.channel.v1.MsgAcknowledgement":
+				mOps, err := handleMsgAcknowledgement(db, dBlock, dTxs[tIndex], dTxs[tIndex].Messages[mIndex].Data)
+				if err != nil {
+					return nil, err
+				}
+
+				ops = append(ops, mOps...)
+			case "/ibc.core.channel.v1.MsgTimeout", "/ibc.core.channel.v1.MsgTimeoutOnClose":
+				mOps, err := handleMsgTimeout(db, dBlock, dTxs[tIndex], dTxs[tIndex].Messages[mIndex].Data)
+				if err != nil {
+					return nil, err
+				}
+
+				ops = append(ops, mOps...)
+			default:
+
+			}
+		}
+	}
+
+	log.Println("EndBlockEventsLen", dBlock.Height, len(dBlock.EndBlockEvents))
+	for eIndex := 0; eIndex < len(dBlock.EndBlockEvents); eIndex++ {
+		log.Println("Type", eIndex, dBlock.EndBlockEvents[eIndex].Type)
+		switch dBlock.EndBlockEvents[eIndex].Type {
+		case "complete_unbonding":
+			event, err := stakingtypes.NewEventCompleteUnbonding(dBlock.EndBlockEvents[eIndex])
+			if err != nil {
+				return nil, err
+			}
+
+			ops = append(
+				ops,
+				operations.NewUnbondingComplete(db, event.Delegator, event.Validator, dBlock.Height, dBlock.Time),
+			)
+		case "complete_redelegation":
+			event, err := stakingtypes.NewEventCompleteRedelegation(dBlock.EndBlockEvents[eIndex])
+			if err != nil {
+				return nil, err
+			}
+
+			ops = append(
+				ops,
+				operations.NewRedelegationComplete(db, event.Delegator, event.SourceValidator, event.DestinationValidator, dBlock.Height, dBlock.Time),
+			)
+		case "active_proposal", "inactive_proposal":
+			event, err := govtypes.NewEventProposalResult(dBlock.EndBlockEvents[eIndex])
+			if err != nil {
+				return nil, err
+			}
+
+			ok, pOps, err := ensureProposal(db, q, proposals, event.ProposalID, dBlock.Height)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			ops = append(ops, pOps...)
+
+			// The dropped proposals are deleted from the state, so there is
+			// no tally to look up for them.
+			var tally *models.ProposalTallyResult
+			if event.Status != govtypes.StatusDropped {
+				if tally, err = finalTallyResult(q, event.ProposalID, dBlock.Height); err != nil {
+					return nil, err
+				}
+			}
+
+			ops = append(
+				ops,
+				operations.NewProposalUpdateStatus(db, event.ProposalID, event.Status, tally, dBlock.Height, dBlock.Time),
+			)
+		default:
+
+		}
+	}
+
+	return ops, nil
+}
 
 func main() {
+	var q *querier.Querier
+	if rpcAddress != "" {
+		encCfg := app.DefaultEncodingConfig()
+
+		var err error
+		if q, err = querier.NewQuerier(encCfg.InterfaceRegistry, strings.Split(rpcAddress, ","), "/websocket"); err != nil {
+			log.Fatalln(err)
+		}
+	}
+
+	db, err := utils.PrepareDatabase(context.TODO(), appName, dbUsername, dbPassword, dbAddress, dbName)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if err = db.Client().Ping(context.TODO(), nil); err != nil {
+		log.Fatalln(err)
+	}
+
+	if err := createIndexes(context.TODO(), db); err != nil {
+		log.Fatalln(err)
+	}
+
+	filter := bson.M{
+		"app_name": appName,
+	}
+
+	dSyncStatus, err := database.SyncStatusFindOne(context.TODO(), db, filter)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if dSyncStatus == nil {
+		dSyncStatus = &models.SyncStatus{
+			AppName:   appName,
+			Height:    fromHeight - 1,
+			Timestamp: time.Time{},
+		}
+	}
+
+	height := dSyncStatus.Height + 1
+	for height < toHeight {
+		now := time.Now()
+		log.Println("Height", height)
+
+		ops, err := run(db, q, height)
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+		log.Println("OperationsLen", len(ops))
+		if len(ops) == 0 {
+			height++
+			continue
+		}
+
+		err = db.Client().UseSession(
+			context.TODO(),
+			func(ctx mongo.SessionContext) error {
+				err := ctx.StartTransaction(
+					options.Transaction().
+						SetReadConcern(readconcern.Snapshot()).
+						SetWriteConcern(writeconcern.Majority()),
+				)
+				if err != nil {
+					return err
+				}
+
+				abort := true
+				defer func() {
+					if abort {
+						_ = ctx.AbortTransaction(ctx)
+					}
+				}()
+
+				for i := 0; i < len(ops); i++ {
+					if err := ops[i](ctx); err != nil {
+						return err
+					}
+				}
+
+				filter := bson.M{
+					"app_name": appName,
+				}
+				update := bson.M{
+					"$set": bson.M{
+						"height": height,
+					},
+				}
+				projection := bson.M{
+					"_id": 1,
+				}
+
+				_, err = database.SyncStatusFindOneAndUpdate(ctx, db, filter, update, options.FindOneAndUpdate().SetProjection(projection).SetUpsert(true))
+				if err != nil {
+					return err
+				}
+
+				height++
+
+				abort = false
+				return ctx.CommitTransaction(ctx)
+			},
+		)
 
+		log.Println("Duration", time.Since(now))
+		log.Println("")
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}
 }
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	hubtypes "github.com/sentinel-official/hub/types"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/querier"
+	"github.com/sentinel-official/explorer/types"
+	distributiontypes "github.com/sentinel-official/explorer/types/distribution"
+)
+
+const delegationsPageLimit = 100
+
+// newDelegationOperation returns the operation updating the delegation of the
+// staking message. With an RPC address the delegation is set to the amount held
+// by the state at the height, which accounts for the slashing and for the
+// delegations made before the first indexed height. Without one, the amount of
+// the message is added to or subtracted from the indexed delegation, which
+// fails once the two diverge.
+func newDelegationOperation(
+	db *mongo.Database, q *querier.Querier, dBlock *models.Block, txHash string,
+	accAddr, valAddr string, amount *types.Coin, add bool,
+) (types.DatabaseOperation, error) {
+	if q == nil {
+		if add {
+			return operations.NewDelegationAdd(db, accAddr, valAddr, amount, dBlock.Height, dBlock.Time, txHash), nil
+		}
+
+		return operations.NewDelegationSubtract(db, accAddr, valAddr, amount, dBlock.Height, dBlock.Time, txHash), nil
+	}
+
+	items, err := q.QueryDelegatorDelegations(querier.ContextWithHeight(context.TODO(), dBlock.Height), accAddr, delegationsPageLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	value := &types.Coin{
+		Denom:  amount.Denom,
+		Amount: "0",
+	}
+	for _, item := range items {
+		if item.Delegation.ValidatorAddress == valAddr {
+			value = types.NewCoin(&item.Balance)
+		}
+	}
+
+	return operations.NewDelegationSet(db, accAddr, valAddr, value, dBlock.Height, dBlock.Time, txHash), nil
+}
+
+// newAutoRewardWithdrawalOperations returns the operations recording the rewards
+// which were withdrawn by a staking message, taken from the withdraw_rewards
+// events between the events of the previous message and the ones of the message.
+func newAutoRewardWithdrawalOperations(db *mongo.Database, dBlock *models.Block, dTx *models.Tx, accAddr string, from, to int) (ops []types.DatabaseOperation, err error) {
+	for i := from; i < to; i++ {
+		if dTx.Result.Events[i].Type != "withdraw_rewards" {
+			continue
+		}
+
+		event, err := distributiontypes.NewEventWithdrawRewards(dTx.Result.Events[i])
+		if err != nil {
+			return nil, err
+		}
+		if len(event.Amount) == 0 {
+			continue
+		}
+
+		dRewardWithdrawal := models.RewardWithdrawal{
+			AccAddr:   accAddr,
+			ValAddr:   event.Validator,
+			Coins:     event.Amount,
+			Height:    dBlock.Height,
+			Timestamp: dBlock.Time,
+			TxHash:    dTx.Hash,
+		}
+
+		ops = append(
+			ops,
+			operations.NewRewardWithdrawalCreate(db, &dRewardWithdrawal),
+		)
+	}
+
+	return ops, nil
+}
+
+// accAddrFromValAddr returns the account address of the operator of the validator.
+func accAddrFromValAddr(s string) (string, error) {
+	_, bz, err := bech32.DecodeAndConvert(s)
+	if err != nil {
+		return "", err
+	}
+
+	return bech32.ConvertAndEncode(hubtypes.Bech32PrefixAccAddr, bz)
+}
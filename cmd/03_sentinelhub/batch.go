@@ -0,0 +1,56 @@
+package main
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+type heightOperations struct {
+	Height int64
+	Ops    []types.DatabaseOperation
+}
+
+var (
+	// Server errors returned when a transaction grows beyond the size or the time limits of Mongo.
+	transactionLimitErrors = map[int]string{
+		257:   "TransactionTooLarge",
+		290:   "TransactionExceededLifetimeLimitSeconds",
+		10334: "BSONObjectTooLarge",
+	}
+)
+
+// isTransactionLimitError reports whether the transaction failed for being too large, which is
+// resolved by splitting the batch. The timeouts and the other transaction errors are not, since a
+// smaller batch does not fix them.
+func isTransactionLimitError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, driver.ErrDocumentTooLarge) {
+		return true
+	}
+
+	var cerr mongo.CommandError
+	if errors.As(err, &cerr) {
+		for code, name := range transactionLimitErrors {
+			if cerr.Code == int32(code) || cerr.Name == name {
+				return true
+			}
+		}
+	}
+
+	var serr mongo.ServerError
+	if errors.As(err, &serr) {
+		for code := range transactionLimitErrors {
+			if serr.HasErrorCode(code) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
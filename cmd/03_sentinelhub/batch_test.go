@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/mongo/driver"
+)
+
+func TestIsTransactionLimitError(t *testing.T) {
+	items := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{errors.New("error"), false},
+		{context.DeadlineExceeded, false},
+		{mongo.CommandError{Code: 50, Name: "MaxTimeMSExpired"}, false},
+		{mongo.CommandError{Code: 251, Name: "NoSuchTransaction"}, false},
+		{mongo.CommandError{Code: 257, Name: "TransactionTooLarge"}, true},
+		{mongo.CommandError{Code: 290, Name: "TransactionExceededLifetimeLimitSeconds"}, true},
+		{mongo.CommandError{Code: 10334, Name: "BSONObjectTooLarge"}, true},
+		{fmt.Errorf("commit: %w", mongo.CommandError{Code: 257}), true},
+		{fmt.Errorf("insert: %w", driver.ErrDocumentTooLarge), true},
+	}
+
+	for _, item := range items {
+		if got := isTransactionLimitError(item.err); got != item.want {
+			t.Fatalf("isTransactionLimitError(%v) = %t, want %t", item.err, got, item.want)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/types"
+	deposittypes "github.com/sentinel-official/explorer/types/deposit"
+)
+
+func registerDepositHandlers(r *Registry) {
+	r.RegisterBeginBlockHandler(handleDepositEventSubtract, "sentinel.deposit.v1.EventSubtract")
+	r.RegisterEndBlockHandler(handleDepositEventSubtract, "sentinel.deposit.v1.EventSubtract")
+}
+
+func handleDepositEventSubtract(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := deposittypes.NewEventSubtract(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypeDepositSubtract,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    "",
+		AccAddr:   event.Address,
+		Coins:     event.Coins,
+	}
+
+	ops = append(
+		ops,
+		operations.NewDepositSubtract(c.DB, event.Address, event.Coins, c.Block.Height, c.Block.Time, ""),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+// registerDepositV3Handlers registers the handlers of the deposit events emitted
+// by the v3 messages, which have no message handlers to consume them.
+func registerDepositV3Handlers(r *Registry) {
+	r.RegisterTxEventHandler(handleDepositEventAddV3, "sentinel.deposit.v1.EventAdd")
+	r.RegisterTxEventHandler(handleDepositEventSubtractV3, "sentinel.deposit.v1.EventSubtract")
+}
+
+// hasSentinelV2Msg reports whether the transaction carries a v2 message, whose
+// handler consumes the deposit events of the transaction itself.
+func hasSentinelV2Msg(c *Context) bool {
+	for _, m := range c.Tx.Messages {
+		if isSentinelMsgType(m.Type) && strings.Contains(m.Type, ".v2.") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func handleDepositEventAddV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	if hasSentinelV2Msg(c) {
+		return nil, nil
+	}
+
+	event, err := deposittypes.NewEventAdd(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypeDepositAdd,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.TxHash(),
+		AccAddr:   event.Address,
+		Coins:     event.Coins,
+	}
+
+	ops = append(
+		ops,
+		operations.NewDepositAdd(c.DB, event.Address, event.Coins, c.Block.Height, c.Block.Time, c.TxHash()),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handleDepositEventSubtractV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	if hasSentinelV2Msg(c) {
+		return nil, nil
+	}
+
+	event, err := deposittypes.NewEventSubtract(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypeDepositSubtract,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.TxHash(),
+		AccAddr:   event.Address,
+		Coins:     event.Coins,
+	}
+
+	ops = append(
+		ops,
+		operations.NewDepositSubtract(c.DB, event.Address, event.Coins, c.Block.Height, c.Block.Time, c.TxHash()),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	hubtypes "github.com/sentinel-official/hub/types"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/types"
+)
+
+// isSentinelMsgType reports whether the type belongs to one of the hub modules.
+func isSentinelMsgType(s string) bool {
+	return strings.HasPrefix(s, "/sentinel.")
+}
+
+// msgNodeAddr returns the node address a message refers to, the node messages are
+// signed by the node itself while the others carry it in their node_address or
+// address fields.
+func msgNodeAddr(m *models.Message) string {
+	for _, key := range []string{"node_address", "address", "from"} {
+		s, ok := m.Data[key].(string)
+		if ok && strings.HasPrefix(s, hubtypes.Bech32PrefixNodeAddr+"1") {
+			return s
+		}
+	}
+
+	return ""
+}
+
+// msgAccAddr returns the account which signed a message.
+func msgAccAddr(m *models.Message) string {
+	if msg, err := m.Msg(); err == nil {
+		if signers := msg.GetSigners(); len(signers) > 0 {
+			return signers[0].String()
+		}
+	}
+
+	s, _ := m.Data["from"].(string)
+	if strings.HasPrefix(s, hubtypes.Bech32PrefixNodeAddr+"1") {
+		addr, err := hubtypes.NodeAddressFromBech32(s)
+		if err != nil {
+			return ""
+		}
+
+		return sdk.AccAddress(addr.Bytes()).String()
+	}
+
+	return s
+}
+
+func handleTxFailure(c *Context, mIndex int, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	dTxFailure := models.TxFailure{
+		AccAddr:   msgAccAddr(m),
+		NodeAddr:  msgNodeAddr(m),
+		MsgIndex:  mIndex,
+		MsgType:   m.Type,
+		MsgData:   m.Data,
+		Codespace: c.Tx.Result.Codespace,
+		Code:      c.Tx.Result.Code,
+		Log:       c.Tx.Result.Log,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.Tx.Hash,
+	}
+
+	ops = append(
+		ops,
+		operations.NewTxFailureCreate(c.DB, &dTxFailure),
+	)
+
+	return ops, nil
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"time"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/types"
+	leasetypes "github.com/sentinel-official/explorer/types/lease"
+)
+
+// registerLeaseHandlers registers the handlers of the lease module, which is
+// added by the hub upgrade. The leases are ended, paid, renewed and updated
+// both by the transactions and at the end of the blocks.
+func registerLeaseHandlers(r *Registry) {
+	r.RegisterTxEventHandler(handleLeaseEventCreate, "sentinel.lease.v1.EventCreate")
+	r.RegisterTxEventHandler(handleLeaseEventEnd, "sentinel.lease.v1.EventEnd")
+	r.RegisterTxEventHandler(handleLeaseEventPay, "sentinel.lease.v1.EventPay")
+	r.RegisterTxEventHandler(handleLeaseEventRefund, "sentinel.lease.v1.EventRefund")
+	r.RegisterTxEventHandler(handleLeaseEventRenew, "sentinel.lease.v1.EventRenew")
+	r.RegisterTxEventHandler(handleLeaseEventUpdate, "sentinel.lease.v1.EventUpdate")
+	r.RegisterEndBlockHandler(handleLeaseEventEnd, "sentinel.lease.v1.EventEnd")
+	r.RegisterEndBlockHandler(handleLeaseEventPay, "sentinel.lease.v1.EventPay")
+	r.RegisterEndBlockHandler(handleLeaseEventRefund, "sentinel.lease.v1.EventRefund")
+	r.RegisterEndBlockHandler(handleLeaseEventRenew, "sentinel.lease.v1.EventRenew")
+	r.RegisterEndBlockHandler(handleLeaseEventUpdate, "sentinel.lease.v1.EventUpdate")
+}
+
+func handleLeaseEventCreate(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := leasetypes.NewEventCreate(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dLease := models.Lease{
+		ID:                 event.ID,
+		NodeAddr:           event.NodeAddress,
+		ProvAddr:           event.ProvAddress,
+		Hours:              0,
+		MaxHours:           event.MaxHours,
+		Price:              event.Price,
+		RenewalPricePolicy: event.RenewalPricePolicy,
+		PayoutAt:           time.Time{},
+		StartHeight:        c.Block.Height,
+		StartTimestamp:     c.Block.Time,
+		StartTxHash:        c.TxHash(),
+		EndHeight:          0,
+		EndTimestamp:       time.Time{},
+		EndTxHash:          "",
+		Status:             hubtypes.StatusActive.String(),
+		StatusHeight:       c.Block.Height,
+		StatusTimestamp:    c.Block.Time,
+		StatusTxHash:       c.TxHash(),
+	}
+
+	ops = append(
+		ops,
+		operations.NewLeaseCreate(c.DB, &dLease),
+	)
+
+	return ops, nil
+}
+
+func handleLeaseEventEnd(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := leasetypes.NewEventEnd(e)
+	if err != nil {
+		return nil, err
+	}
+
+	status := hubtypes.StatusInactive.String()
+	dEvent1 := models.Event{
+		Type:      types.EventTypeLeaseUpdateStatus,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.TxHash(),
+		LeaseID:   event.ID,
+		NodeAddr:  event.NodeAddress,
+		ProvAddr:  event.ProvAddress,
+		Status:    status,
+	}
+
+	ops = append(
+		ops,
+		operations.NewLeaseUpdateStatus(c.DB, event.ID, status, c.Block.Height, c.Block.Time, c.TxHash()),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handleLeaseEventPay(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := leasetypes.NewEventPay(e)
+	if err != nil {
+		return nil, err
+	}
+
+	ops = append(
+		ops,
+		operations.NewLeasePaymentAdd(c.DB, event.ID, event.Payment, event.StakingReward),
+	)
+
+	return ops, nil
+}
+
+func handleLeaseEventRefund(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := leasetypes.NewEventRefund(e)
+	if err != nil {
+		return nil, err
+	}
+
+	ops = append(
+		ops,
+		operations.NewLeaseUpdateDetails(c.DB, event.ID, -1, -1, nil, time.Time{}, event.Amount),
+	)
+
+	return ops, nil
+}
+
+// handleLeaseEventRenew starts the hours of the lease over, with the maximum
+// hours and the price of the renewal.
+func handleLeaseEventRenew(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := leasetypes.NewEventRenew(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypeLeaseUpdateDetails,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.TxHash(),
+		LeaseID:   event.ID,
+		NodeAddr:  event.NodeAddress,
+		ProvAddr:  event.ProvAddress,
+		Duration:  event.MaxHours,
+	}
+	if event.Price != nil {
+		dEvent1.Coins = types.Coins{event.Price}
+	}
+
+	ops = append(
+		ops,
+		operations.NewLeaseUpdateDetails(c.DB, event.ID, 0, event.MaxHours, event.Price, time.Time{}, nil),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+// handleLeaseEventUpdate indexes the hours paid for so far, the event is
+// emitted on every hourly payout and is not recorded as an event.
+func handleLeaseEventUpdate(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := leasetypes.NewEventUpdate(e)
+	if err != nil {
+		return nil, err
+	}
+
+	ops = append(
+		ops,
+		operations.NewLeaseUpdateDetails(c.DB, event.ID, event.Hours, -1, nil, event.PayoutAt, nil),
+	)
+
+	return ops, nil
+}
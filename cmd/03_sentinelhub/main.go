@@ -8,7 +8,6 @@ import (
 	"math"
 	"time"
 
-	hubtypes "github.com/sentinel-official/hub/types"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -16,15 +15,9 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
 
 	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/migrations"
 	"github.com/sentinel-official/explorer/models"
-	"github.com/sentinel-official/explorer/operations"
 	"github.com/sentinel-official/explorer/types"
-	deposittypes "github.com/sentinel-official/explorer/types/deposit"
-	nodetypes "github.com/sentinel-official/explorer/types/node"
-	plantypes "github.com/sentinel-official/explorer/types/plan"
-	providertypes "github.com/sentinel-official/explorer/types/provider"
-	sessiontypes "github.com/sentinel-official/explorer/types/session"
-	subscriptiontypes "github.com/sentinel-official/explorer/types/subscription"
 	"github.com/sentinel-official/explorer/utils"
 )
 
@@ -33,12 +26,14 @@ const (
 )
 
 var (
-	fromHeight int64
-	toHeight   int64
-	dbAddress  string
-	dbName     string
-	dbUsername string
-	dbPassword string
+	fromHeight      int64
+	toHeight        int64
+	dbAddress       string
+	dbName          string
+	dbUsername      string
+	dbPassword      string
+	batchHeights    int
+	v3UpgradeHeight int64
 )
 
 func init() {
@@ -50,170 +45,55 @@ func init() {
 	flag.StringVar(&dbName, "db-name", "sentinelhub-2", "")
 	flag.StringVar(&dbUsername, "db-username", "", "")
 	flag.StringVar(&dbPassword, "db-password", "", "")
-	flag.Parse()
+	flag.IntVar(&batchHeights, "batch-heights", 1, "")
+	// The v3 handlers are used from the upgrade height, which defaults to
+	// none, indexing the v2 modules only.
+	flag.Int64Var(&v3UpgradeHeight, "v3-upgrade-height", math.MaxInt64, "")
 }
 
 func createIndexes(ctx context.Context, db *mongo.Database) error {
-	indexes := []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "app_name", Value: 1},
-			},
-			Options: options.Index().
-				SetUnique(true),
-		},
-	}
-
-	_, err := database.SyncStatusIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	indexes = []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "height", Value: 1},
-			},
-			Options: options.Index().
-				SetUnique(true),
-		},
-	}
-
-	_, err = database.BlockIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	indexes = []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "height", Value: 1},
-				bson.E{Key: "result.code", Value: 1},
-			},
-			Options: options.Index().
-				SetPartialFilterExpression(
-					bson.M{
-						"result.code": 0,
-					},
-				),
-		},
-	}
-
-	_, err = database.TxIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	indexes = []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "addr", Value: 1},
-			},
-			Options: options.Index().
-				SetUnique(true),
-		},
-	}
-
-	_, err = database.DepositIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	indexes = []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "addr", Value: 1},
-			},
-			Options: options.Index().
-				SetUnique(true),
-		},
-	}
-
-	_, err = database.NodeIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	indexes = []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "id", Value: 1},
-			},
-			Options: options.Index().
-				SetUnique(true),
-		},
-	}
-
-	_, err = database.PlanIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	indexes = []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "addr", Value: 1},
-			},
-			Options: options.Index().
-				SetUnique(true),
-		},
-	}
-
-	_, err = database.ProviderIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	indexes = []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "id", Value: 1},
-			},
-			Options: options.Index().
-				SetUnique(true),
-		},
-	}
-
-	_, err = database.SessionIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	indexes = []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "id", Value: 1},
-			},
-			Options: options.Index().
-				SetUnique(true),
-		},
-	}
-
-	_, err = database.SubscriptionIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	indexes = []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "id", Value: 1},
-				bson.E{Key: "acc_addr", Value: 1},
-			},
-			Options: options.Index().
-				SetUnique(true),
-		},
-	}
-
-	_, err = database.SubscriptionAllocationIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
+	return migrations.CreateIndexes(
+		ctx, db,
+		database.SyncStatusCollectionName,
+		database.BlockCollectionName,
+		database.TxCollectionName,
+		database.DepositCollectionName,
+		database.LeaseCollectionName,
+		database.NodeCollectionName,
+		database.PlanCollectionName,
+		database.ProviderCollectionName,
+		database.SessionCollectionName,
+		database.SubscriptionCollectionName,
+		database.SubscriptionAllocationCollectionName,
+		database.TxFailureCollectionName,
+	)
+}
 
-	return nil
+// newRegistry returns the registry of the v2 handlers, along with the v3 ones
+// for the heights since the hub upgrade.
+func newRegistry(v3 bool) *Registry {
+	r := NewRegistry()
+	registerDepositHandlers(r)
+	registerNodeHandlers(r)
+	registerPlanHandlers(r)
+	registerProviderHandlers(r)
+	registerSessionHandlers(r)
+	registerSubscriptionHandlers(r)
+
+	if v3 {
+		registerDepositV3Handlers(r)
+		registerLeaseHandlers(r)
+		registerNodeV3Handlers(r)
+		registerPlanV3Handlers(r)
+		registerProviderV3Handlers(r)
+		registerSessionV3Handlers(r)
+		registerSubscriptionV3Handlers(r)
+	}
+
+	return r
 }
 
-func run(db *mongo.Database, height int64) (ops []types.DatabaseOperation, err error) {
+func run(db *mongo.Database, r *Registry, height int64) (ops []types.DatabaseOperation, err error) {
 	filter := bson.M{
 		"height": height,
 	}
@@ -232,54 +112,22 @@ func run(db *mongo.Database, height int64) (ops []types.DatabaseOperation, err e
 		return nil, fmt.Errorf("block %d does not exist", height)
 	}
 
+	c := &Context{
+		DB:    db,
+		Block: dBlock,
+	}
+
 	log.Println("BeginBlockEventsLen", dBlock.Height, len(dBlock.BeginBlockEvents))
 	for eIndex := 0; eIndex < len(dBlock.BeginBlockEvents); eIndex++ {
 		log.Println("Type", eIndex, dBlock.BeginBlockEvents[eIndex].Type)
-		switch dBlock.BeginBlockEvents[eIndex].Type {
-		case "sentinel.deposit.v1.EventSubtract":
-			event, err := deposittypes.NewEventSubtract(dBlock.BeginBlockEvents[eIndex])
-			if err != nil {
-				return nil, err
-			}
-
-			dEvent1 := models.Event{
-				Type:      types.EventTypeDepositSubtract,
-				Height:    dBlock.Height,
-				Timestamp: dBlock.Time,
-				TxHash:    "",
-				AccAddr:   event.Address,
-				Coins:     event.Coins,
-			}
-
-			ops = append(
-				ops,
-				operations.NewDepositSubtract(db, event.Address, event.Coins, dBlock.Height, dBlock.Time, ""),
-				operations.NewEventCreate(db, &dEvent1),
-			)
-		case "sentinel.subscription.v2.EventPayForPayout":
-			event, err := subscriptiontypes.NewEventPayForPayout(dBlock.BeginBlockEvents[eIndex])
-			if err != nil {
-				return nil, err
-			}
-
-			dSubscriptionPayout := models.SubscriptionPayout{
-				ID:            event.ID,
-				AccAddr:       event.Address,
-				NodeAddr:      event.NodeAddress,
-				Payment:       event.Payment,
-				StakingReward: event.StakingReward,
-				Height:        dBlock.Height,
-				Timestamp:     dBlock.Time,
-				TxHash:        "",
-			}
-
-			ops = append(
-				ops,
-				operations.NewSubscriptionPayoutCreate(db, &dSubscriptionPayout),
-			)
-		default:
 
+		c.EIndex = eIndex
+		eOps, err := r.HandleBeginBlockEvent(c, dBlock.BeginBlockEvents[eIndex])
+		if err != nil {
+			return nil, err
 		}
+
+		ops = append(ops, eOps...)
 	}
 
 	filter = bson.M{
@@ -303,700 +151,148 @@ func run(db *mongo.Database, height int64) (ops []types.DatabaseOperation, err e
 		log.Println("TxHash", dTxs[tIndex].Hash)
 		log.Println("MessagesLen", tIndex, len(dTxs[tIndex].Messages))
 
-		for eIndex, mIndex := -1, 0; mIndex < len(dTxs[tIndex].Messages); mIndex++ {
+		c.Tx, c.EIndex = dTxs[tIndex], -1
+		for mIndex := 0; mIndex < len(dTxs[tIndex].Messages); mIndex++ {
 			log.Println("Type", dTxs[tIndex].Messages[mIndex].Type)
-			switch dTxs[tIndex].Messages[mIndex].Type {
-			case "/sentinel.node.v2.MsgRegisterRequest", "/sentinel.node.v2.MsgService/MsgRegister":
-				msg, err := nodetypes.NewMsgRegisterRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
-
-				dNode := models.Node{
-					Addr:              msg.NodeAddr().String(),
-					GigabytePrices:    msg.GigabytePrices,
-					HourlyPrices:      msg.HourlyPrices,
-					RemoteURL:         msg.RemoteURL,
-					RegisterHeight:    dBlock.Height,
-					RegisterTimestamp: dBlock.Time,
-					RegisterTxHash:    dTxs[tIndex].Hash,
-					Status:            hubtypes.StatusInactive.String(),
-					StatusHeight:      dBlock.Height,
-					StatusTimestamp:   dBlock.Time,
-					StatusTxHash:      dTxs[tIndex].Hash,
-				}
-
-				ops = append(
-					ops,
-					operations.NewNodeRegister(db, &dNode),
-				)
-			case "/sentinel.node.v2.MsgUpdateDetailsRequest", "/sentinel.node.v2.MsgService/MsgUpdateDetails":
-				msg, err := nodetypes.NewMsgUpdateDetailsRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
-
-				dEvent1 := models.Event{
-					Type:           types.EventTypeNodeUpdateDetails,
-					Height:         dBlock.Height,
-					Timestamp:      dBlock.Time,
-					TxHash:         dTxs[tIndex].Hash,
-					NodeAddr:       msg.From,
-					GigabytePrices: msg.GigabytePrices,
-					HourlyPrices:   msg.HourlyPrices,
-					RemoteURL:      msg.RemoteURL,
-				}
-
-				ops = append(
-					ops,
-					operations.NewNodeUpdateDetails(db, msg.From, msg.GigabytePrices, msg.HourlyPrices, msg.RemoteURL),
-					operations.NewEventCreate(db, &dEvent1),
-				)
-			case "/sentinel.node.v2.MsgUpdateStatusRequest", "/sentinel.node.v2.MsgService/MsgUpdateStatus":
-				msg, err := nodetypes.NewMsgUpdateStatusRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
-
-				dEvent1 := models.Event{
-					Type:      types.EventTypeNodeUpdateStatus,
-					Height:    dBlock.Height,
-					Timestamp: dBlock.Time,
-					TxHash:    dTxs[tIndex].Hash,
-					NodeAddr:  msg.From,
-					Status:    msg.Status,
-				}
-
-				ops = append(
-					ops,
-					operations.NewNodeUpdateStatus(db, msg.From, msg.Status, dBlock.Height, dBlock.Time, dTxs[tIndex].Hash),
-					operations.NewEventCreate(db, &dEvent1),
-				)
-			case "/sentinel.node.v2.MsgSubscribeRequest", "/sentinel.node.v2.MsgService/MsgSubscribe":
-				msg, err := nodetypes.NewMsgSubscribeRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
-
-				var (
-					eventAdd                *deposittypes.EventAdd
-					eventAllocate           *subscriptiontypes.EventAllocate
-					eventCreateSubscription *nodetypes.EventCreateSubscription
-				)
-
-				eIndex, eventAdd, err = deposittypes.NewEventAddFromEvents(dTxs[tIndex].Result.Events, eIndex+1)
-				if err != nil {
-					return nil, err
-				}
-
-				if msg.Gigabytes != 0 {
-					eIndex, eventAllocate, err = subscriptiontypes.NewEventAllocateFromEvents(dTxs[tIndex].Result.Events, eIndex+1)
-					if err != nil {
-						return nil, err
-					}
-				}
-
-				eIndex, eventCreateSubscription, err = nodetypes.NewEventCreateSubscriptionFromEvents(dTxs[tIndex].Result.Events, eIndex+1)
-				if err != nil {
-					return nil, err
-				}
-
-				inactiveAt := dBlock.Time.Add(90 * 24 * time.Hour)
-				if msg.Hours != 0 {
-					inactiveAt = dBlock.Time.Add(time.Duration(msg.Hours) * time.Hour)
-				}
-
-				dSubscription := models.Subscription{
-					ID:              eventCreateSubscription.ID,
-					AccAddr:         msg.From,
-					NodeAddr:        msg.NodeAddress,
-					Gigabytes:       msg.Gigabytes,
-					Hours:           msg.Hours,
-					Price:           nil,
-					Deposit:         eventAdd.Coins[0],
-					Refund:          nil,
-					InactiveAt:      inactiveAt,
-					StartHeight:     dBlock.Height,
-					StartTimestamp:  dBlock.Time,
-					StartTxHash:     dTxs[tIndex].Hash,
-					EndHeight:       0,
-					EndTimestamp:    time.Time{},
-					EndTxHash:       "",
-					Status:          hubtypes.StatusActive.String(),
-					StatusHeight:    dBlock.Height,
-					StatusTimestamp: dBlock.Time,
-					StatusTxHash:    dTxs[tIndex].Hash,
-				}
-
-				dEvent1 := models.Event{
-					Type:      types.EventTypeDepositAdd,
-					Height:    dBlock.Height,
-					Timestamp: dBlock.Time,
-					TxHash:    dTxs[tIndex].Hash,
-					AccAddr:   eventAdd.Address,
-					Coins:     eventAdd.Coins,
-				}
-
-				ops = append(
-					ops,
-					operations.NewSubscriptionCreate(db, &dSubscription),
-					operations.NewDepositAdd(db, eventAdd.Address, eventAdd.Coins, dBlock.Height, dBlock.Time, dTxs[tIndex].Hash),
-					operations.NewEventCreate(db, &dEvent1),
-				)
-
-				if msg.Gigabytes != 0 {
-					dSubscriptionAllocation := models.SubscriptionAllocation{
-						ID:            eventAllocate.ID,
-						AccAddr:       eventAllocate.Address,
-						GrantedBytes:  eventAllocate.GrantedBytes,
-						UtilisedBytes: eventAllocate.UtilisedBytes,
-					}
-
-					dEvent1 := models.Event{
-						Type:           types.EventTypeSubscriptionAllocationUpdateDetails,
-						Height:         dBlock.Height,
-						Timestamp:      dBlock.Time,
-						TxHash:         dTxs[tIndex].Hash,
-						SubscriptionID: eventAllocate.ID,
-						AccAddr:        eventAllocate.Address,
-						GrantedBytes:   eventAllocate.GrantedBytes,
-						UtilisedBytes:  eventAllocate.UtilisedBytes,
-					}
-
-					ops = append(
-						ops,
-						operations.NewSubscriptionAllocationCreate(db, &dSubscriptionAllocation),
-						operations.NewEventCreate(db, &dEvent1),
-					)
-				}
-			case "/sentinel.plan.v2.MsgCreateRequest", "/sentinel.plan.v2.MsgService/MsgCreate":
-				msg, err := plantypes.NewMsgCreateRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
-
-				var (
-					eventCreate *plantypes.EventCreate
-				)
-
-				eIndex, eventCreate, err = plantypes.NewEventCreateFromEvents(dTxs[tIndex].Result.Events, eIndex+1)
-				if err != nil {
-					return nil, err
-				}
-
-				dPlan := models.Plan{
-					ID:              eventCreate.ID,
-					ProvAddr:        msg.From,
-					Prices:          msg.Prices,
-					Duration:        msg.Duration,
-					Gigabytes:       msg.Gigabytes,
-					NodeAddrs:       []string{},
-					CreateHeight:    dBlock.Height,
-					CreateTimestamp: dBlock.Time,
-					CreateTxHash:    dTxs[tIndex].Hash,
-					Status:          hubtypes.StatusInactive.String(),
-					StatusHeight:    dBlock.Height,
-					StatusTimestamp: dBlock.Time,
-					StatusTxHash:    dTxs[tIndex].Hash,
-				}
-
-				ops = append(
-					ops,
-					operations.NewPlanCreate(db, &dPlan),
-				)
-			case "/sentinel.plan.v2.MsgUpdateStatusRequest", "/sentinel.plan.v2.MsgService/MsgUpdateStatus":
-				msg, err := plantypes.NewMsgUpdateStatusRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
-
-				dEvent1 := models.Event{
-					Type:      types.EventTypePlanUpdateStatus,
-					Height:    dBlock.Height,
-					Timestamp: dBlock.Time,
-					TxHash:    dTxs[tIndex].Hash,
-					PlanID:    msg.ID,
-					Status:    msg.Status,
-				}
-
-				ops = append(
-					ops,
-					operations.NewPlanUpdateStatus(db, msg.ID, msg.Status, dBlock.Height, dBlock.Time, dTxs[tIndex].Hash),
-					operations.NewEventCreate(db, &dEvent1),
-				)
-			case "/sentinel.plan.v2.MsgLinkNodeRequest", "/sentinel.plan.v2.MsgService/MsgLinkNode":
-				msg, err := plantypes.NewMsgLinkNodeRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
-
-				dEvent1 := models.Event{
-					Type:      types.EventTypePlanLinkNode,
-					Height:    dBlock.Height,
-					Timestamp: dBlock.Time,
-					TxHash:    dTxs[tIndex].Hash,
-					PlanID:    msg.ID,
-					NodeAddr:  msg.NodeAddress,
-				}
-
-				ops = append(
-					ops,
-					operations.NewPlanLinkNode(db, msg.ID, msg.NodeAddress),
-					operations.NewEventCreate(db, &dEvent1),
-				)
-			case "/sentinel.plan.v2.MsgUnlinkNodeRequest", "/sentinel.plan.v2.MsgService/MsgUnlinkNode":
-				msg, err := plantypes.NewMsgUnlinkNodeRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
-
-				dEvent1 := models.Event{
-					Type:      types.EventTypePlanUnlinkNode,
-					Height:    dBlock.Height,
-					Timestamp: dBlock.Time,
-					TxHash:    dTxs[tIndex].Hash,
-					PlanID:    msg.ID,
-					NodeAddr:  msg.NodeAddress,
-				}
-
-				ops = append(
-					ops,
-					operations.NewPlanUnlinkNode(db, msg.ID, msg.NodeAddress),
-					operations.NewEventCreate(db, &dEvent1),
-				)
-			case "/sentinel.plan.v2.MsgSubscribeRequest", "/sentinel.plan.v2.MsgService/MsgSubscribe":
-				msg, err := plantypes.NewMsgSubscribeRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
-
-				var (
-					eventPayForPlan         *subscriptiontypes.EventPayForPlan
-					eventAllocate           *subscriptiontypes.EventAllocate
-					eventCreateSubscription *plantypes.EventCreateSubscription
-				)
-
-				eIndex, eventPayForPlan, err = subscriptiontypes.NewEventPayForPlanFromEvents(dTxs[tIndex].Result.Events, eIndex+1)
-				if err != nil {
-					return nil, err
-				}
-
-				eIndex, eventAllocate, err = subscriptiontypes.NewEventAllocateFromEvents(dTxs[tIndex].Result.Events, eIndex+1)
-				if err != nil {
-					return nil, err
-				}
-
-				eIndex, eventCreateSubscription, err = plantypes.NewEventCreateSubscriptionFromEvents(dTxs[tIndex].Result.Events, eIndex+1)
-				if err != nil {
-					return nil, err
-				}
 
-				dSubscription := models.Subscription{
-					ID:              eventCreateSubscription.ID,
-					AccAddr:         msg.From,
-					PlanID:          msg.ID,
-					Price:           nil,
-					Payment:         eventPayForPlan.Payment,
-					StakingReward:   eventPayForPlan.StakingReward,
-					InactiveAt:      time.Time{},
-					StartHeight:     dBlock.Height,
-					StartTimestamp:  dBlock.Time,
-					StartTxHash:     dTxs[tIndex].Hash,
-					EndHeight:       0,
-					EndTimestamp:    time.Time{},
-					EndTxHash:       "",
-					Status:          hubtypes.StatusActive.String(),
-					StatusHeight:    dBlock.Height,
-					StatusTimestamp: dBlock.Time,
-					StatusTxHash:    dTxs[tIndex].Hash,
-				}
-
-				dSubscriptionAllocation := models.SubscriptionAllocation{
-					ID:            eventAllocate.ID,
-					AccAddr:       eventAllocate.Address,
-					GrantedBytes:  eventAllocate.GrantedBytes,
-					UtilisedBytes: eventAllocate.UtilisedBytes,
-				}
-
-				dEvent1 := models.Event{
-					Type:           types.EventTypeSubscriptionAllocationUpdateDetails,
-					Height:         dBlock.Height,
-					Timestamp:      dBlock.Time,
-					TxHash:         dTxs[tIndex].Hash,
-					SubscriptionID: eventAllocate.ID,
-					AccAddr:        eventAllocate.Address,
-					GrantedBytes:   eventAllocate.GrantedBytes,
-					UtilisedBytes:  eventAllocate.UtilisedBytes,
-				}
-
-				ops = append(
-					ops,
-					operations.NewSubscriptionCreate(db, &dSubscription),
-					operations.NewSubscriptionAllocationCreate(db, &dSubscriptionAllocation),
-					operations.NewEventCreate(db, &dEvent1),
-				)
-			case "/sentinel.provider.v2.MsgRegisterRequest", "/sentinel.provider.v2.MsgService/MsgRegister":
-				msg, err := providertypes.NewMsgRegisterRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
-
-				dProvider := models.Provider{
-					Addr:              msg.ProvAddr().String(),
-					Name:              msg.Name,
-					Identity:          msg.Identity,
-					Website:           msg.Website,
-					Description:       msg.Description,
-					RegisterHeight:    dBlock.Height,
-					RegisterTimestamp: dBlock.Time,
-					RegisterTxHash:    dTxs[tIndex].Hash,
-					Status:            hubtypes.StatusInactive.String(),
-					StatusHeight:      dBlock.Height,
-					StatusTimestamp:   dBlock.Time,
-					StatusTxHash:      dTxs[tIndex].Hash,
-				}
-
-				ops = append(
-					ops,
-					operations.NewProviderRegister(db, &dProvider),
-				)
-			case "/sentinel.provider.v2.MsgUpdateRequest", "/sentinel.provider.v2.MsgService/MsgUpdate":
-				msg, err := providertypes.NewMsgUpdateRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
-
-				dEvent1 := models.Event{
-					Type:        types.EventTypeProviderUpdateDetails,
-					Height:      dBlock.Height,
-					Timestamp:   dBlock.Time,
-					TxHash:      dTxs[tIndex].Hash,
-					ProvAddr:    msg.From,
-					Name:        msg.Name,
-					Identity:    msg.Identity,
-					Website:     msg.Website,
-					Description: msg.Description,
-					Status:      msg.Status,
-				}
-
-				ops = append(
-					ops,
-					operations.NewProviderUpdate(db, msg.From, msg.Name, msg.Identity, msg.Website, msg.Description, msg.Status),
-					operations.NewEventCreate(db, &dEvent1),
-				)
-			case "/sentinel.session.v2.MsgStartRequest", "/sentinel.session.v2.MsgService/MsgStart":
-				msg, err := sessiontypes.NewMsgStartRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
-
-				var (
-					eventStart *sessiontypes.EventStart
-				)
-
-				eIndex, eventStart, err = sessiontypes.NewEventStartFromEvents(dTxs[tIndex].Result.Events, eIndex+1)
-				if err != nil {
-					return nil, err
-				}
-
-				dSession := models.Session{
-					ID:              eventStart.ID,
-					SubscriptionID:  msg.ID,
-					AccAddr:         msg.From,
-					NodeAddr:        msg.NodeAddress,
-					Bandwidth:       nil,
-					Duration:        0,
-					Payment:         nil,
-					StakingReward:   nil,
-					Rating:          0,
-					StartHeight:     dBlock.Height,
-					StartTimestamp:  dBlock.Time,
-					StartTxHash:     dTxs[tIndex].Hash,
-					EndHeight:       0,
-					EndTimestamp:    time.Time{},
-					EndTxHash:       "",
-					Status:          hubtypes.StatusActive.String(),
-					StatusHeight:    dBlock.Height,
-					StatusTimestamp: dBlock.Time,
-					StatusTxHash:    dTxs[tIndex].Hash,
-				}
-
-				ops = append(
-					ops,
-					operations.NewSessionCreate(db, &dSession),
-				)
-			case "/sentinel.session.v2.MsgUpdateDetailsRequest", "/sentinel.session.v2.MsgService/MsgUpdate":
-				msg, err := sessiontypes.NewMsgUpdateDetailsRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
-
-				dEvent1 := models.Event{
-					Type:      types.EventTypeSessionUpdateDetails,
-					Height:    dBlock.Height,
-					Timestamp: dBlock.Time,
-					TxHash:    dTxs[tIndex].Hash,
-					SessionID: msg.ID,
-					Bandwidth: msg.Bandwidth,
-					Duration:  msg.Duration,
-				}
-
-				ops = append(
-					ops,
-					operations.NewSessionUpdateDetails(db, msg.ID, msg.Bandwidth, msg.Duration, nil, nil, -1),
-					operations.NewEventCreate(db, &dEvent1),
-				)
-			case "/sentinel.session.v2.MsgEndRequest", "/sentinel.session.v2.MsgService/MsgEnd":
-				msg, err := sessiontypes.NewMsgEndRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
-
-				status := hubtypes.StatusInactivePending.String()
-				dEvent1 := models.Event{
-					Type:      types.EventTypeSessionUpdateStatus,
-					Height:    dBlock.Height,
-					Timestamp: dBlock.Time,
-					TxHash:    dTxs[tIndex].Hash,
-					SessionID: msg.ID,
-					Status:    status,
-				}
+			mOps, err := r.HandleMsg(c, dTxs[tIndex].Messages[mIndex])
+			if err != nil {
+				return nil, err
+			}
 
-				ops = append(
-					ops,
-					operations.NewSessionUpdateDetails(db, msg.ID, nil, -1, nil, nil, msg.Rating),
-					operations.NewSessionUpdateStatus(db, msg.ID, status, dBlock.Height, dBlock.Time, dTxs[tIndex].Hash),
-					operations.NewEventCreate(db, &dEvent1),
-				)
-			case "/sentinel.subscription.v2.MsgCancelRequest", "/sentinel.subscription.v2.MsgService/MsgCancel":
-				msg, err := subscriptiontypes.NewMsgCancelRequest(dTxs[tIndex].Messages[mIndex].Data)
-				if err != nil {
-					return nil, err
-				}
+			ops = append(ops, mOps...)
+		}
 
-				status := hubtypes.StatusInactivePending.String()
-				dEvent1 := models.Event{
-					Type:           types.EventTypeSubscriptionUpdateStatus,
-					Height:         dBlock.Height,
-					Timestamp:      dBlock.Time,
-					TxHash:         dTxs[tIndex].Hash,
-					SubscriptionID: msg.ID,
-					Status:         status,
-				}
+		if dTxs[tIndex].Result == nil {
+			continue
+		}
 
-				ops = append(
-					ops,
-					operations.NewSubscriptionUpdateStatus(db, msg.ID, status, dBlock.Height, dBlock.Time, dTxs[tIndex].Hash),
-					operations.NewEventCreate(db, &dEvent1),
-				)
-			case "/sentinel.subscription.v2.MsgAllocateRequest", "/sentinel.subscription.v2.MsgService/MsgAllocate":
-				var (
-					eventAllocate1 *subscriptiontypes.EventAllocate
-					eventAllocate2 *subscriptiontypes.EventAllocate
-				)
+		for eIndex := 0; eIndex < len(dTxs[tIndex].Result.Events); eIndex++ {
+			eOps, err := r.HandleTxEvent(c, dTxs[tIndex].Result.Events[eIndex])
+			if err != nil {
+				return nil, err
+			}
 
-				eIndex, eventAllocate1, err = subscriptiontypes.NewEventAllocateFromEvents(dTxs[tIndex].Result.Events, eIndex+1)
-				if err != nil {
-					return nil, err
-				}
+			ops = append(ops, eOps...)
+		}
+	}
 
-				dEvent1 := models.Event{
-					Type:           types.EventTypeSubscriptionAllocationUpdateDetails,
-					Height:         dBlock.Height,
-					Timestamp:      dBlock.Time,
-					TxHash:         dTxs[tIndex].Hash,
-					SubscriptionID: eventAllocate1.ID,
-					AccAddr:        eventAllocate1.Address,
-					GrantedBytes:   eventAllocate1.GrantedBytes,
-					UtilisedBytes:  eventAllocate1.UtilisedBytes,
-				}
+	filter = bson.M{
+		"height": height,
+		"result.code": bson.M{
+			"$gt": 0,
+		},
+	}
+	projection = bson.M{
+		"hash":             1,
+		"messages":         1,
+		"result.codespace": 1,
+		"result.code":      1,
+		"result.log":       1,
+	}
 
-				eIndex, eventAllocate2, err = subscriptiontypes.NewEventAllocateFromEvents(dTxs[tIndex].Result.Events, eIndex+1)
-				if err != nil {
-					return nil, err
-				}
+	dFailedTxs, err := database.TxFind(context.TODO(), db, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, err
+	}
 
-				dEvent2 := models.Event{
-					Type:           types.EventTypeSubscriptionAllocationUpdateDetails,
-					Height:         dBlock.Height,
-					Timestamp:      dBlock.Time,
-					TxHash:         dTxs[tIndex].Hash,
-					SubscriptionID: eventAllocate1.ID,
-					AccAddr:        eventAllocate1.Address,
-					GrantedBytes:   eventAllocate1.GrantedBytes,
-					UtilisedBytes:  eventAllocate1.UtilisedBytes,
-				}
+	log.Println("FailedTxsLen", len(dFailedTxs))
+	for tIndex := 0; tIndex < len(dFailedTxs); tIndex++ {
+		dFailedTxs[tIndex].Messages = dFailedTxs[tIndex].Messages.WithAuthzMsgExecMessages()
 
-				ops = append(
-					ops,
-					operations.NewSubscriptionAllocationUpdate(db, eventAllocate1.ID, eventAllocate1.Address, eventAllocate1.GrantedBytes, eventAllocate1.UtilisedBytes),
-					operations.NewEventCreate(db, &dEvent1),
-					operations.NewSubscriptionAllocationUpdate(db, eventAllocate2.ID, eventAllocate2.Address, eventAllocate2.GrantedBytes, eventAllocate2.UtilisedBytes),
-					operations.NewEventCreate(db, &dEvent2),
-				)
-			default:
+		c.Tx = dFailedTxs[tIndex]
+		for mIndex := 0; mIndex < len(dFailedTxs[tIndex].Messages); mIndex++ {
+			if !isSentinelMsgType(dFailedTxs[tIndex].Messages[mIndex].Type) {
+				continue
+			}
 
+			mOps, err := handleTxFailure(c, mIndex, dFailedTxs[tIndex].Messages[mIndex])
+			if err != nil {
+				return nil, err
 			}
+
+			ops = append(ops, mOps...)
 		}
 	}
 
+	c.Tx = nil
+
 	log.Println("EndBlockEventsLen", dBlock.Height, len(dBlock.EndBlockEvents))
 	for eIndex := 0; eIndex < len(dBlock.EndBlockEvents); eIndex++ {
 		log.Println("Type", eIndex, dBlock.EndBlockEvents[eIndex].Type)
-		switch dBlock.EndBlockEvents[eIndex].Type {
-		case "sentinel.deposit.v1.EventSubtract":
-			event, err := deposittypes.NewEventSubtract(dBlock.EndBlockEvents[eIndex])
-			if err != nil {
-				return nil, err
-			}
-
-			dEvent1 := models.Event{
-				Type:      types.EventTypeDepositSubtract,
-				Height:    dBlock.Height,
-				Timestamp: dBlock.Time,
-				TxHash:    "",
-				AccAddr:   event.Address,
-				Coins:     event.Coins,
-			}
-
-			ops = append(
-				ops,
-				operations.NewDepositSubtract(db, event.Address, event.Coins, dBlock.Height, dBlock.Time, ""),
-				operations.NewEventCreate(db, &dEvent1),
-			)
-		case "sentinel.node.v2.EventUpdateDetails":
-			event, err := nodetypes.NewEventUpdateDetails(dBlock.EndBlockEvents[eIndex])
-			if err != nil {
-				return nil, err
-			}
 
-			dEvent1 := models.Event{
-				Type:           types.EventTypeNodeUpdateDetails,
-				Height:         dBlock.Height,
-				Timestamp:      dBlock.Time,
-				TxHash:         "",
-				NodeAddr:       event.Address,
-				GigabytePrices: event.GigabytePrices,
-				HourlyPrices:   event.HourlyPrices,
-				RemoteURL:      event.RemoteURL,
-			}
+		c.EIndex = eIndex
+		eOps, err := r.HandleEndBlockEvent(c, dBlock.EndBlockEvents[eIndex])
+		if err != nil {
+			return nil, err
+		}
 
-			ops = append(
-				ops,
-				operations.NewNodeUpdateDetails(db, event.Address, event.GigabytePrices, event.HourlyPrices, event.RemoteURL),
-				operations.NewEventCreate(db, &dEvent1),
-			)
-		case "sentinel.node.v2.EventUpdateStatus":
-			event, err := nodetypes.NewEventUpdateStatus(dBlock.EndBlockEvents[eIndex])
-			if err != nil {
-				return nil, err
-			}
+		ops = append(ops, eOps...)
+	}
 
-			dEvent1 := models.Event{
-				Type:      types.EventTypeNodeUpdateStatus,
-				Height:    dBlock.Height,
-				Timestamp: dBlock.Time,
-				TxHash:    "",
-				NodeAddr:  event.Address,
-				Status:    event.Status,
-			}
+	return ops, nil
+}
 
-			ops = append(
-				ops,
-				operations.NewNodeUpdateStatus(db, event.Address, event.Status, dBlock.Height, dBlock.Time, ""),
-				operations.NewEventCreate(db, &dEvent1),
+// commit applies the operations and moves the sync status to the given height within a single transaction.
+func commit(db *mongo.Database, ops []types.DatabaseOperation, height int64) error {
+	return db.Client().UseSession(
+		context.TODO(),
+		func(ctx mongo.SessionContext) error {
+			err := ctx.StartTransaction(
+				options.Transaction().
+					SetReadConcern(readconcern.Snapshot()).
+					SetWriteConcern(writeconcern.Majority()),
 			)
-		case "sentinel.session.v2.EventUpdateStatus":
-			event, err := sessiontypes.NewEventUpdateStatus(dBlock.EndBlockEvents[eIndex])
 			if err != nil {
-				return nil, err
+				return err
 			}
 
-			dEvent1 := models.Event{
-				Type:      types.EventTypeSessionUpdateStatus,
-				Height:    dBlock.Height,
-				Timestamp: dBlock.Time,
-				TxHash:    "",
-				SessionID: event.ID,
-				Status:    event.Status,
-			}
+			abort := true
+			defer func() {
+				if abort {
+					_ = ctx.AbortTransaction(ctx)
+				}
+			}()
 
-			ops = append(
-				ops,
-				operations.NewSessionUpdateStatus(db, event.ID, event.Status, dBlock.Height, dBlock.Time, ""),
-				operations.NewEventCreate(db, &dEvent1),
-			)
-		case "sentinel.subscription.v2.EventPayForSession":
-			event, err := subscriptiontypes.NewEventPayForSession(dBlock.EndBlockEvents[eIndex])
-			if err != nil {
-				return nil, err
+			for i := 0; i < len(ops); i++ {
+				if err := ops[i](ctx); err != nil {
+					return err
+				}
 			}
 
-			ops = append(
-				ops,
-				operations.NewSessionUpdateDetails(db, event.ID, nil, -1, event.Payment, event.StakingReward, -1),
-			)
-		case "sentinel.subscription.v2.EventUpdateStatus":
-			event, err := subscriptiontypes.NewEventUpdateStatus(dBlock.EndBlockEvents[eIndex])
-			if err != nil {
-				return nil, err
+			filter := bson.M{
+				"app_name": appName,
 			}
-
-			dEvent1 := models.Event{
-				Type:           types.EventTypeSubscriptionUpdateStatus,
-				Height:         dBlock.Height,
-				Timestamp:      dBlock.Time,
-				TxHash:         "",
-				SubscriptionID: event.ID,
-				Status:         event.Status,
+			update := bson.M{
+				"$set": bson.M{
+					"height": height,
+				},
 			}
-
-			ops = append(
-				ops,
-				operations.NewSubscriptionUpdateStatus(db, event.ID, event.Status, dBlock.Height, dBlock.Time, ""),
-				operations.NewEventCreate(db, &dEvent1),
-			)
-		case "sentinel.subscription.v2.EventRefund":
-			event, err := subscriptiontypes.NewEventRefund(dBlock.EndBlockEvents[eIndex])
-			if err != nil {
-				return nil, err
+			projection := bson.M{
+				"_id": 1,
 			}
 
-			ops = append(
-				ops,
-				operations.NewSubscriptionUpdateDetails(db, event.ID, event.Amount),
-			)
-		case "sentinel.subscription.v2.EventAllocate":
-			event, err := subscriptiontypes.NewEventAllocate(dBlock.EndBlockEvents[eIndex])
+			_, err = database.SyncStatusFindOneAndUpdate(ctx, db, filter, update, options.FindOneAndUpdate().SetProjection(projection).SetUpsert(true))
 			if err != nil {
-				return nil, err
+				return err
 			}
 
-			dEvent1 := models.Event{
-				Type:           types.EventTypeSubscriptionAllocationUpdateDetails,
-				Height:         dBlock.Height,
-				Timestamp:      dBlock.Time,
-				TxHash:         "",
-				SubscriptionID: event.ID,
-				AccAddr:        event.Address,
-				GrantedBytes:   event.GrantedBytes,
-				UtilisedBytes:  event.UtilisedBytes,
-			}
+			abort = false
+			return ctx.CommitTransaction(ctx)
+		},
+	)
+}
 
-			ops = append(
-				ops,
-				operations.NewSubscriptionAllocationUpdate(db, event.ID, event.Address, event.GrantedBytes, event.UtilisedBytes),
-				operations.NewEventCreate(db, &dEvent1),
-			)
-		default:
+func main() {
+	flag.Parse()
 
-		}
+	if batchHeights < 1 {
+		log.Fatalln("batch-heights must be greater than zero")
+	}
+	if v3UpgradeHeight < 1 {
+		log.Fatalln("v3-upgrade-height must be greater than zero")
 	}
 
-	return ops, nil
-}
-
-func main() {
 	db, err := utils.PrepareDatabase(context.TODO(), appName, dbUsername, dbPassword, dbAddress, dbName)
 	if err != nil {
 		log.Fatalln(err)
@@ -1026,70 +322,61 @@ func main() {
 		}
 	}
 
+	var (
+		rV2 = newRegistry(false)
+		rV3 = newRegistry(true)
+	)
+
 	height := dSyncStatus.Height + 1
 	for height < toHeight {
 		now := time.Now()
-		log.Println("Height", height)
 
-		ops, err := run(db, height)
-		if err != nil {
-			log.Fatalln(err)
-		}
+		var (
+			batch  []*heightOperations
+			opsLen = 0
+		)
 
-		log.Println("OperationsLen", len(ops))
-		if len(ops) == 0 {
-			height++
-			continue
-		}
+		for i := 0; height < toHeight && i < batchHeights; i, height = i+1, height+1 {
+			log.Println("Height", height)
 
-		err = db.Client().UseSession(
-			context.TODO(),
-			func(ctx mongo.SessionContext) error {
-				err := ctx.StartTransaction(
-					options.Transaction().
-						SetReadConcern(readconcern.Snapshot()).
-						SetWriteConcern(writeconcern.Majority()),
-				)
-				if err != nil {
-					return err
-				}
+			r := rV2
+			if height >= v3UpgradeHeight {
+				r = rV3
+			}
 
-				abort := true
-				defer func() {
-					if abort {
-						_ = ctx.AbortTransaction(ctx)
-					}
-				}()
+			ops, err := run(db, r, height)
+			if err != nil {
+				log.Fatalln(err)
+			}
 
-				for i := 0; i < len(ops); i++ {
-					if err := ops[i](ctx); err != nil {
-						return err
-					}
-				}
+			log.Println("OperationsLen", len(ops))
+			if len(ops) == 0 {
+				continue
+			}
 
-				filter := bson.M{
-					"app_name": appName,
-				}
-				update := bson.M{
-					"$set": bson.M{
-						"height": height,
-					},
-				}
-				projection := bson.M{
-					"_id": 1,
-				}
+			opsLen += len(ops)
+			batch = append(batch, &heightOperations{Height: height, Ops: ops})
+		}
 
-				_, err = database.SyncStatusFindOneAndUpdate(ctx, db, filter, update, options.FindOneAndUpdate().SetProjection(projection).SetUpsert(true))
-				if err != nil {
-					return err
-				}
+		log.Println("BatchLen", len(batch), "BatchOperationsLen", opsLen)
+		if len(batch) == 0 {
+			continue
+		}
 
-				height++
+		var ops []types.DatabaseOperation
+		for i := 0; i < len(batch); i++ {
+			ops = append(ops, batch[i].Ops...)
+		}
 
-				abort = false
-				return ctx.CommitTransaction(ctx)
-			},
-		)
+		err := commit(db, ops, batch[len(batch)-1].Height)
+		if len(batch) > 1 && isTransactionLimitError(err) {
+			log.Println("Falling back to per-height commits", err)
+			for i := 0; i < len(batch); i++ {
+				if err = commit(db, batch[i].Ops, batch[i].Height); err != nil {
+					break
+				}
+			}
+		}
 
 		log.Println("Duration", time.Since(now))
 		log.Println("")
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"time"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/types"
+	deposittypes "github.com/sentinel-official/explorer/types/deposit"
+	nodetypes "github.com/sentinel-official/explorer/types/node"
+	subscriptiontypes "github.com/sentinel-official/explorer/types/subscription"
+)
+
+func registerNodeHandlers(r *Registry) {
+	r.RegisterMsgHandler(handleNodeMsgRegisterRequest, "/sentinel.node.v2.MsgRegisterRequest", "/sentinel.node.v2.MsgService/MsgRegister")
+	r.RegisterMsgHandler(handleNodeMsgUpdateDetailsRequest, "/sentinel.node.v2.MsgUpdateDetailsRequest", "/sentinel.node.v2.MsgService/MsgUpdateDetails")
+	r.RegisterMsgHandler(handleNodeMsgUpdateStatusRequest, "/sentinel.node.v2.MsgUpdateStatusRequest", "/sentinel.node.v2.MsgService/MsgUpdateStatus")
+	r.RegisterMsgHandler(handleNodeMsgSubscribeRequest, "/sentinel.node.v2.MsgSubscribeRequest", "/sentinel.node.v2.MsgService/MsgSubscribe")
+	r.RegisterEndBlockHandler(handleNodeEventUpdateDetails, "sentinel.node.v2.EventUpdateDetails")
+	r.RegisterEndBlockHandler(handleNodeEventUpdateStatus, "sentinel.node.v2.EventUpdateStatus")
+}
+
+func handleNodeMsgRegisterRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := nodetypes.NewMsgRegisterRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	dNode := models.Node{
+		Addr:              msg.NodeAddr().String(),
+		GigabytePrices:    msg.GigabytePrices,
+		HourlyPrices:      msg.HourlyPrices,
+		RemoteURL:         msg.RemoteURL,
+		RegisterHeight:    c.Block.Height,
+		RegisterTimestamp: c.Block.Time,
+		RegisterTxHash:    c.Tx.Hash,
+		Status:            hubtypes.StatusInactive.String(),
+		StatusHeight:      c.Block.Height,
+		StatusTimestamp:   c.Block.Time,
+		StatusTxHash:      c.Tx.Hash,
+	}
+
+	ops = append(
+		ops,
+		operations.NewNodeRegister(c.DB, &dNode),
+	)
+
+	return ops, nil
+}
+
+func handleNodeMsgUpdateDetailsRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := nodetypes.NewMsgUpdateDetailsRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:           types.EventTypeNodeUpdateDetails,
+		Height:         c.Block.Height,
+		Timestamp:      c.Block.Time,
+		TxHash:         c.Tx.Hash,
+		NodeAddr:       msg.From,
+		GigabytePrices: msg.GigabytePrices,
+		HourlyPrices:   msg.HourlyPrices,
+		RemoteURL:      msg.RemoteURL,
+	}
+
+	ops = append(
+		ops,
+		operations.NewNodeUpdateDetails(c.DB, msg.From, msg.GigabytePrices, msg.HourlyPrices, msg.RemoteURL),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handleNodeMsgUpdateStatusRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := nodetypes.NewMsgUpdateStatusRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypeNodeUpdateStatus,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.Tx.Hash,
+		NodeAddr:  msg.From,
+		Status:    msg.Status,
+	}
+
+	ops = append(
+		ops,
+		operations.NewNodeUpdateStatus(c.DB, msg.From, msg.Status, c.Block.Height, c.Block.Time, c.Tx.Hash),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handleNodeMsgSubscribeRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := nodetypes.NewMsgSubscribeRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		eventAdd                *deposittypes.EventAdd
+		eventAllocate           *subscriptiontypes.EventAllocate
+		eventCreateSubscription *nodetypes.EventCreateSubscription
+	)
+
+	c.EIndex, eventAdd, err = deposittypes.NewEventAddFromEvents(c.Tx.Result.Events, c.EIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Gigabytes != 0 {
+		c.EIndex, eventAllocate, err = subscriptiontypes.NewEventAllocateFromEvents(c.Tx.Result.Events, c.EIndex+1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.EIndex, eventCreateSubscription, err = nodetypes.NewEventCreateSubscriptionFromEvents(c.Tx.Result.Events, c.EIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	inactiveAt := c.Block.Time.Add(90 * 24 * time.Hour)
+	if msg.Hours != 0 {
+		inactiveAt = c.Block.Time.Add(time.Duration(msg.Hours) * time.Hour)
+	}
+
+	dSubscription := models.Subscription{
+		ID:              eventCreateSubscription.ID,
+		AccAddr:         msg.From,
+		NodeAddr:        msg.NodeAddress,
+		Gigabytes:       msg.Gigabytes,
+		Hours:           msg.Hours,
+		Price:           nil,
+		Deposit:         eventAdd.Coins[0],
+		Refund:          nil,
+		InactiveAt:      inactiveAt,
+		StartHeight:     c.Block.Height,
+		StartTimestamp:  c.Block.Time,
+		StartTxHash:     c.Tx.Hash,
+		EndHeight:       0,
+		EndTimestamp:    time.Time{},
+		EndTxHash:       "",
+		Status:          hubtypes.StatusActive.String(),
+		StatusHeight:    c.Block.Height,
+		StatusTimestamp: c.Block.Time,
+		StatusTxHash:    c.Tx.Hash,
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypeDepositAdd,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.Tx.Hash,
+		AccAddr:   eventAdd.Address,
+		Coins:     eventAdd.Coins,
+	}
+
+	ops = append(
+		ops,
+		operations.NewSubscriptionCreate(c.DB, &dSubscription),
+		operations.NewDepositAdd(c.DB, eventAdd.Address, eventAdd.Coins, c.Block.Height, c.Block.Time, c.Tx.Hash),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	if msg.Gigabytes != 0 {
+		dSubscriptionAllocation := models.SubscriptionAllocation{
+			ID:            eventAllocate.ID,
+			AccAddr:       eventAllocate.Address,
+			GrantedBytes:  eventAllocate.GrantedBytes,
+			UtilisedBytes: eventAllocate.UtilisedBytes,
+		}
+
+		dEvent1 := models.Event{
+			Type:           types.EventTypeSubscriptionAllocationUpdateDetails,
+			Height:         c.Block.Height,
+			Timestamp:      c.Block.Time,
+			TxHash:         c.Tx.Hash,
+			SubscriptionID: eventAllocate.ID,
+			AccAddr:        eventAllocate.Address,
+			GrantedBytes:   eventAllocate.GrantedBytes,
+			UtilisedBytes:  eventAllocate.UtilisedBytes,
+		}
+
+		ops = append(
+			ops,
+			operations.NewSubscriptionAllocationCreate(c.DB, &dSubscriptionAllocation),
+			operations.NewEventCreate(c.DB, &dEvent1),
+		)
+	}
+
+	return ops, nil
+}
+
+func handleNodeEventUpdateDetails(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := nodetypes.NewEventUpdateDetails(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:           types.EventTypeNodeUpdateDetails,
+		Height:         c.Block.Height,
+		Timestamp:      c.Block.Time,
+		TxHash:         "",
+		NodeAddr:       event.Address,
+		GigabytePrices: event.GigabytePrices,
+		HourlyPrices:   event.HourlyPrices,
+		RemoteURL:      event.RemoteURL,
+	}
+
+	ops = append(
+		ops,
+		operations.NewNodeUpdateDetails(c.DB, event.Address, event.GigabytePrices, event.HourlyPrices, event.RemoteURL),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handleNodeEventUpdateStatus(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := nodetypes.NewEventUpdateStatus(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypeNodeUpdateStatus,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    "",
+		NodeAddr:  event.Address,
+		Status:    event.Status,
+	}
+
+	ops = append(
+		ops,
+		operations.NewNodeUpdateStatus(c.DB, event.Address, event.Status, c.Block.Height, c.Block.Time, ""),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
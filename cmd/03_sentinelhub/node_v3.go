@@ -0,0 +1,191 @@
+package main
+
+import (
+	"time"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/types"
+	nodetypes "github.com/sentinel-official/explorer/types/node"
+	subscriptiontypes "github.com/sentinel-official/explorer/types/subscription"
+)
+
+func registerNodeV3Handlers(r *Registry) {
+	r.RegisterTxEventHandler(handleNodeEventCreateV3, "sentinel.node.v3.EventCreate")
+	r.RegisterTxEventHandler(handleNodeEventUpdateDetailsV3, "sentinel.node.v3.EventUpdateDetails")
+	r.RegisterTxEventHandler(handleNodeEventUpdateStatusV3, "sentinel.node.v3.EventUpdateStatus")
+	r.RegisterTxEventHandler(handleNodeEventCreateSessionV3, "sentinel.node.v3.EventCreateSession")
+	r.RegisterTxEventHandler(handleNodeEventPayV3, "sentinel.node.v3.EventPay")
+	r.RegisterTxEventHandler(handleNodeEventRefundV3, "sentinel.node.v3.EventRefund")
+	r.RegisterEndBlockHandler(handleNodeEventUpdateStatusV3, "sentinel.node.v3.EventUpdateStatus")
+	r.RegisterEndBlockHandler(handleNodeEventPayV3, "sentinel.node.v3.EventPay")
+	r.RegisterEndBlockHandler(handleNodeEventRefundV3, "sentinel.node.v3.EventRefund")
+}
+
+func handleNodeEventCreateV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := nodetypes.NewEventCreateV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dNode := models.Node{
+		Addr:              event.NodeAddress,
+		GigabytePrices:    event.GigabytePrices,
+		HourlyPrices:      event.HourlyPrices,
+		RemoteURL:         event.RemoteURL,
+		RegisterHeight:    c.Block.Height,
+		RegisterTimestamp: c.Block.Time,
+		RegisterTxHash:    c.TxHash(),
+		Status:            hubtypes.StatusInactive.String(),
+		StatusHeight:      c.Block.Height,
+		StatusTimestamp:   c.Block.Time,
+		StatusTxHash:      c.TxHash(),
+	}
+
+	ops = append(
+		ops,
+		operations.NewNodeRegister(c.DB, &dNode),
+	)
+
+	return ops, nil
+}
+
+func handleNodeEventUpdateDetailsV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := nodetypes.NewEventUpdateDetailsV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:           types.EventTypeNodeUpdateDetails,
+		Height:         c.Block.Height,
+		Timestamp:      c.Block.Time,
+		TxHash:         c.TxHash(),
+		NodeAddr:       event.NodeAddress,
+		GigabytePrices: event.GigabytePrices,
+		HourlyPrices:   event.HourlyPrices,
+		RemoteURL:      event.RemoteURL,
+	}
+
+	ops = append(
+		ops,
+		operations.NewNodeUpdateDetails(c.DB, event.NodeAddress, event.GigabytePrices, event.HourlyPrices, event.RemoteURL),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handleNodeEventUpdateStatusV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := nodetypes.NewEventUpdateStatusV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypeNodeUpdateStatus,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.TxHash(),
+		NodeAddr:  event.NodeAddress,
+		Status:    event.Status,
+	}
+
+	ops = append(
+		ops,
+		operations.NewNodeUpdateStatus(c.DB, event.NodeAddress, event.Status, c.Block.Height, c.Block.Time, c.TxHash()),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+// hasSubscriptionSessionV3 reports whether the transaction started the session
+// through a subscription as well.
+func hasSubscriptionSessionV3(c *Context, id uint64) bool {
+	if c.Tx == nil || c.Tx.Result == nil {
+		return false
+	}
+
+	for _, e := range c.Tx.Result.Events {
+		if e.Type != "sentinel.subscription.v3.EventCreateSession" {
+			continue
+		}
+
+		event, err := subscriptiontypes.NewEventCreateSessionV3(e)
+		if err == nil && event.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handleNodeEventCreateSessionV3 indexes the sessions started directly on the
+// node, which are paid from the deposit of the account and so have no
+// subscription. The sessions started through a subscription are indexed by
+// handleSubscriptionEventCreateSessionV3 with their subscription ID.
+func handleNodeEventCreateSessionV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := nodetypes.NewEventCreateSessionV3(e)
+	if err != nil {
+		return nil, err
+	}
+	if hasSubscriptionSessionV3(c, event.ID) {
+		return nil, nil
+	}
+
+	dSession := models.Session{
+		ID:              event.ID,
+		SubscriptionID:  0,
+		AccAddr:         event.AccAddress,
+		NodeAddr:        event.NodeAddress,
+		Bandwidth:       nil,
+		Duration:        0,
+		Payment:         nil,
+		StakingReward:   nil,
+		Rating:          0,
+		StartHeight:     c.Block.Height,
+		StartTimestamp:  c.Block.Time,
+		StartTxHash:     c.TxHash(),
+		EndHeight:       0,
+		EndTimestamp:    time.Time{},
+		EndTxHash:       "",
+		Status:          hubtypes.StatusActive.String(),
+		StatusHeight:    c.Block.Height,
+		StatusTimestamp: c.Block.Time,
+		StatusTxHash:    c.TxHash(),
+	}
+
+	ops = append(
+		ops,
+		operations.NewSessionCreate(c.DB, &dSession),
+	)
+
+	return ops, nil
+}
+
+func handleNodeEventPayV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := nodetypes.NewEventPayV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	ops = append(
+		ops,
+		operations.NewSessionUpdateDetails(c.DB, event.ID, nil, -1, event.Payment, event.StakingReward, -1),
+	)
+
+	return ops, nil
+}
+
+// handleNodeEventRefundV3 is a no-op, the refunded amount is indexed from the
+// sentinel.deposit.v1.EventSubtract event emitted along with it.
+func handleNodeEventRefundV3(_ *Context, e *types.Event) ([]types.DatabaseOperation, error) {
+	if _, err := nodetypes.NewEventRefundV3(e); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
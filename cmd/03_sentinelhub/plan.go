@@ -0,0 +1,207 @@
+package main
+
+import (
+	"time"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/types"
+	plantypes "github.com/sentinel-official/explorer/types/plan"
+	subscriptiontypes "github.com/sentinel-official/explorer/types/subscription"
+)
+
+func registerPlanHandlers(r *Registry) {
+	r.RegisterMsgHandler(handlePlanMsgCreateRequest, "/sentinel.plan.v2.MsgCreateRequest", "/sentinel.plan.v2.MsgService/MsgCreate")
+	r.RegisterMsgHandler(handlePlanMsgUpdateStatusRequest, "/sentinel.plan.v2.MsgUpdateStatusRequest", "/sentinel.plan.v2.MsgService/MsgUpdateStatus")
+	r.RegisterMsgHandler(handlePlanMsgLinkNodeRequest, "/sentinel.plan.v2.MsgLinkNodeRequest", "/sentinel.plan.v2.MsgService/MsgLinkNode")
+	r.RegisterMsgHandler(handlePlanMsgUnlinkNodeRequest, "/sentinel.plan.v2.MsgUnlinkNodeRequest", "/sentinel.plan.v2.MsgService/MsgUnlinkNode")
+	r.RegisterMsgHandler(handlePlanMsgSubscribeRequest, "/sentinel.plan.v2.MsgSubscribeRequest", "/sentinel.plan.v2.MsgService/MsgSubscribe")
+}
+
+func handlePlanMsgCreateRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := plantypes.NewMsgCreateRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		eventCreate *plantypes.EventCreate
+	)
+
+	c.EIndex, eventCreate, err = plantypes.NewEventCreateFromEvents(c.Tx.Result.Events, c.EIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	dPlan := models.Plan{
+		ID:              eventCreate.ID,
+		ProvAddr:        msg.From,
+		Prices:          msg.Prices,
+		Duration:        msg.Duration,
+		Gigabytes:       msg.Gigabytes,
+		NodeAddrs:       []string{},
+		CreateHeight:    c.Block.Height,
+		CreateTimestamp: c.Block.Time,
+		CreateTxHash:    c.Tx.Hash,
+		Status:          hubtypes.StatusInactive.String(),
+		StatusHeight:    c.Block.Height,
+		StatusTimestamp: c.Block.Time,
+		StatusTxHash:    c.Tx.Hash,
+	}
+
+	ops = append(
+		ops,
+		operations.NewPlanCreate(c.DB, &dPlan),
+	)
+
+	return ops, nil
+}
+
+func handlePlanMsgUpdateStatusRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := plantypes.NewMsgUpdateStatusRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypePlanUpdateStatus,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.Tx.Hash,
+		PlanID:    msg.ID,
+		Status:    msg.Status,
+	}
+
+	ops = append(
+		ops,
+		operations.NewPlanUpdateStatus(c.DB, msg.ID, msg.Status, c.Block.Height, c.Block.Time, c.Tx.Hash),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handlePlanMsgLinkNodeRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := plantypes.NewMsgLinkNodeRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypePlanLinkNode,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.Tx.Hash,
+		PlanID:    msg.ID,
+		NodeAddr:  msg.NodeAddress,
+	}
+
+	ops = append(
+		ops,
+		operations.NewPlanLinkNode(c.DB, msg.ID, msg.NodeAddress),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handlePlanMsgUnlinkNodeRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := plantypes.NewMsgUnlinkNodeRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypePlanUnlinkNode,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.Tx.Hash,
+		PlanID:    msg.ID,
+		NodeAddr:  msg.NodeAddress,
+	}
+
+	ops = append(
+		ops,
+		operations.NewPlanUnlinkNode(c.DB, msg.ID, msg.NodeAddress),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handlePlanMsgSubscribeRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := plantypes.NewMsgSubscribeRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		eventPayForPlan         *subscriptiontypes.EventPayForPlan
+		eventAllocate           *subscriptiontypes.EventAllocate
+		eventCreateSubscription *plantypes.EventCreateSubscription
+	)
+
+	c.EIndex, eventPayForPlan, err = subscriptiontypes.NewEventPayForPlanFromEvents(c.Tx.Result.Events, c.EIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	c.EIndex, eventAllocate, err = subscriptiontypes.NewEventAllocateFromEvents(c.Tx.Result.Events, c.EIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	c.EIndex, eventCreateSubscription, err = plantypes.NewEventCreateSubscriptionFromEvents(c.Tx.Result.Events, c.EIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	dSubscription := models.Subscription{
+		ID:              eventCreateSubscription.ID,
+		AccAddr:         msg.From,
+		PlanID:          msg.ID,
+		Price:           nil,
+		Payment:         eventPayForPlan.Payment,
+		StakingReward:   eventPayForPlan.StakingReward,
+		InactiveAt:      time.Time{},
+		StartHeight:     c.Block.Height,
+		StartTimestamp:  c.Block.Time,
+		StartTxHash:     c.Tx.Hash,
+		EndHeight:       0,
+		EndTimestamp:    time.Time{},
+		EndTxHash:       "",
+		Status:          hubtypes.StatusActive.String(),
+		StatusHeight:    c.Block.Height,
+		StatusTimestamp: c.Block.Time,
+		StatusTxHash:    c.Tx.Hash,
+	}
+
+	dSubscriptionAllocation := models.SubscriptionAllocation{
+		ID:            eventAllocate.ID,
+		AccAddr:       eventAllocate.Address,
+		GrantedBytes:  eventAllocate.GrantedBytes,
+		UtilisedBytes: eventAllocate.UtilisedBytes,
+	}
+
+	dEvent1 := models.Event{
+		Type:           types.EventTypeSubscriptionAllocationUpdateDetails,
+		Height:         c.Block.Height,
+		Timestamp:      c.Block.Time,
+		TxHash:         c.Tx.Hash,
+		SubscriptionID: eventAllocate.ID,
+		AccAddr:        eventAllocate.Address,
+		GrantedBytes:   eventAllocate.GrantedBytes,
+		UtilisedBytes:  eventAllocate.UtilisedBytes,
+	}
+
+	ops = append(
+		ops,
+		operations.NewSubscriptionCreate(c.DB, &dSubscription),
+		operations.NewSubscriptionAllocationCreate(c.DB, &dSubscriptionAllocation),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
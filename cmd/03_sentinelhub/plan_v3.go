@@ -0,0 +1,124 @@
+package main
+
+import (
+	hubtypes "github.com/sentinel-official/hub/types"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/types"
+	plantypes "github.com/sentinel-official/explorer/types/plan"
+)
+
+func registerPlanV3Handlers(r *Registry) {
+	r.RegisterTxEventHandler(handlePlanEventCreateV3, "sentinel.plan.v3.EventCreate")
+	r.RegisterTxEventHandler(handlePlanEventLinkNodeV3, "sentinel.plan.v3.EventLinkNode")
+	r.RegisterTxEventHandler(handlePlanEventUnlinkNodeV3, "sentinel.plan.v3.EventUnlinkNode")
+	r.RegisterTxEventHandler(handlePlanEventUpdateV3, "sentinel.plan.v3.EventUpdate")
+}
+
+func handlePlanEventCreateV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := plantypes.NewEventCreateV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dPlan := models.Plan{
+		ID:              event.ID,
+		ProvAddr:        event.ProvAddress,
+		Prices:          event.Prices,
+		Duration:        event.Duration,
+		Gigabytes:       event.Gigabytes,
+		NodeAddrs:       []string{},
+		CreateHeight:    c.Block.Height,
+		CreateTimestamp: c.Block.Time,
+		CreateTxHash:    c.TxHash(),
+		Status:          hubtypes.StatusInactive.String(),
+		StatusHeight:    c.Block.Height,
+		StatusTimestamp: c.Block.Time,
+		StatusTxHash:    c.TxHash(),
+	}
+
+	ops = append(
+		ops,
+		operations.NewPlanCreate(c.DB, &dPlan),
+	)
+
+	return ops, nil
+}
+
+func handlePlanEventLinkNodeV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := plantypes.NewEventLinkNodeV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypePlanLinkNode,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.TxHash(),
+		PlanID:    event.ID,
+		NodeAddr:  event.NodeAddress,
+	}
+
+	ops = append(
+		ops,
+		operations.NewPlanLinkNode(c.DB, event.ID, event.NodeAddress),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handlePlanEventUnlinkNodeV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := plantypes.NewEventUnlinkNodeV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypePlanUnlinkNode,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.TxHash(),
+		PlanID:    event.ID,
+		NodeAddr:  event.NodeAddress,
+	}
+
+	ops = append(
+		ops,
+		operations.NewPlanUnlinkNode(c.DB, event.ID, event.NodeAddress),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+// handlePlanEventUpdateV3 indexes only the status, the v3 event is emitted
+// for the other plan updates as well.
+func handlePlanEventUpdateV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := plantypes.NewEventUpdateV3(e)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status == "" {
+		return nil, nil
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypePlanUpdateStatus,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.TxHash(),
+		PlanID:    event.ID,
+		Status:    event.Status,
+	}
+
+	ops = append(
+		ops,
+		operations.NewPlanUpdateStatus(c.DB, event.ID, event.Status, c.Block.Height, c.Block.Time, c.TxHash()),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	hubtypes "github.com/sentinel-official/hub/types"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/types"
+	providertypes "github.com/sentinel-official/explorer/types/provider"
+)
+
+func registerProviderHandlers(r *Registry) {
+	r.RegisterMsgHandler(handleProviderMsgRegisterRequest, "/sentinel.provider.v2.MsgRegisterRequest", "/sentinel.provider.v2.MsgService/MsgRegister")
+	r.RegisterMsgHandler(handleProviderMsgUpdateRequest, "/sentinel.provider.v2.MsgUpdateRequest", "/sentinel.provider.v2.MsgService/MsgUpdate")
+}
+
+func handleProviderMsgRegisterRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := providertypes.NewMsgRegisterRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	dProvider := models.Provider{
+		Addr:              msg.ProvAddr().String(),
+		Name:              msg.Name,
+		Identity:          msg.Identity,
+		Website:           msg.Website,
+		Description:       msg.Description,
+		RegisterHeight:    c.Block.Height,
+		RegisterTimestamp: c.Block.Time,
+		RegisterTxHash:    c.Tx.Hash,
+		Status:            hubtypes.StatusInactive.String(),
+		StatusHeight:      c.Block.Height,
+		StatusTimestamp:   c.Block.Time,
+		StatusTxHash:      c.Tx.Hash,
+	}
+
+	ops = append(
+		ops,
+		operations.NewProviderRegister(c.DB, &dProvider),
+	)
+
+	return ops, nil
+}
+
+func handleProviderMsgUpdateRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := providertypes.NewMsgUpdateRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:        types.EventTypeProviderUpdateDetails,
+		Height:      c.Block.Height,
+		Timestamp:   c.Block.Time,
+		TxHash:      c.Tx.Hash,
+		ProvAddr:    msg.From,
+		Name:        msg.Name,
+		Identity:    msg.Identity,
+		Website:     msg.Website,
+		Description: msg.Description,
+		Status:      msg.Status,
+	}
+
+	ops = append(
+		ops,
+		operations.NewProviderUpdate(c.DB, msg.From, msg.Name, msg.Identity, msg.Website, msg.Description, msg.Status),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	hubtypes "github.com/sentinel-official/hub/types"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/types"
+	providertypes "github.com/sentinel-official/explorer/types/provider"
+)
+
+// registerProviderV3Handlers registers the handlers of the typed events, which
+// carry the data of the v3 provider messages the pinned hub codec cannot decode.
+func registerProviderV3Handlers(r *Registry) {
+	r.RegisterTxEventHandler(handleProviderEventCreateV3, "sentinel.provider.v3.EventCreate")
+	r.RegisterTxEventHandler(handleProviderEventUpdateDetailsV3, "sentinel.provider.v3.EventUpdateDetails")
+	r.RegisterTxEventHandler(handleProviderEventUpdateStatusV3, "sentinel.provider.v3.EventUpdateStatus")
+}
+
+func handleProviderEventCreateV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := providertypes.NewEventCreateV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dProvider := models.Provider{
+		Addr:              event.ProvAddress,
+		Name:              event.Name,
+		Identity:          event.Identity,
+		Website:           event.Website,
+		Description:       event.Description,
+		RegisterHeight:    c.Block.Height,
+		RegisterTimestamp: c.Block.Time,
+		RegisterTxHash:    c.TxHash(),
+		Status:            hubtypes.StatusInactive.String(),
+		StatusHeight:      c.Block.Height,
+		StatusTimestamp:   c.Block.Time,
+		StatusTxHash:      c.TxHash(),
+	}
+
+	ops = append(
+		ops,
+		operations.NewProviderRegister(c.DB, &dProvider),
+	)
+
+	return ops, nil
+}
+
+func handleProviderEventUpdateDetailsV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := providertypes.NewEventUpdateDetailsV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:        types.EventTypeProviderUpdateDetails,
+		Height:      c.Block.Height,
+		Timestamp:   c.Block.Time,
+		TxHash:      c.TxHash(),
+		ProvAddr:    event.ProvAddress,
+		Name:        event.Name,
+		Identity:    event.Identity,
+		Website:     event.Website,
+		Description: event.Description,
+	}
+
+	ops = append(
+		ops,
+		operations.NewProviderUpdate(c.DB, event.ProvAddress, event.Name, event.Identity, event.Website, event.Description, ""),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handleProviderEventUpdateStatusV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := providertypes.NewEventUpdateStatusV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypeProviderUpdateDetails,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.TxHash(),
+		ProvAddr:  event.ProvAddress,
+		Status:    event.Status,
+	}
+
+	ops = append(
+		ops,
+		operations.NewProviderUpdate(c.DB, event.ProvAddress, "", "", "", "", event.Status),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
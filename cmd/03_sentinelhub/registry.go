@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+// Context carries the state shared by the handlers of a single block.
+// EIndex is the index of the last consumed event of Tx and is advanced by the
+// message handlers which read their events from the transaction result.
+type Context struct {
+	DB     *mongo.Database
+	Block  *models.Block
+	Tx     *models.Tx
+	EIndex int
+}
+
+// TxHash returns the hash of the current transaction, or an empty string while
+// handling the begin/end-block events.
+func (c *Context) TxHash() string {
+	if c.Tx == nil {
+		return ""
+	}
+
+	return c.Tx.Hash
+}
+
+type (
+	MsgHandler   func(c *Context, m *models.Message) ([]types.DatabaseOperation, error)
+	EventHandler func(c *Context, e *types.Event) ([]types.DatabaseOperation, error)
+)
+
+// Registry maps the message, the transaction event and the begin/end-block
+// event types to their handlers.
+type Registry struct {
+	msgHandlers        map[string]MsgHandler
+	txEventHandlers    map[string]EventHandler
+	txEventPrefixes    map[string]bool
+	beginBlockHandlers map[string]EventHandler
+	endBlockHandlers   map[string]EventHandler
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		msgHandlers:        make(map[string]MsgHandler),
+		txEventHandlers:    make(map[string]EventHandler),
+		txEventPrefixes:    make(map[string]bool),
+		beginBlockHandlers: make(map[string]EventHandler),
+		endBlockHandlers:   make(map[string]EventHandler),
+	}
+}
+
+func (r *Registry) RegisterMsgHandler(handler MsgHandler, msgTypes ...string) {
+	for _, msgType := range msgTypes {
+		if _, ok := r.msgHandlers[msgType]; ok {
+			panic(fmt.Errorf("duplicate message handler for type %s", msgType))
+		}
+
+		r.msgHandlers[msgType] = handler
+	}
+}
+
+func (r *Registry) RegisterTxEventHandler(handler EventHandler, eventTypes ...string) {
+	for _, eventType := range eventTypes {
+		if _, ok := r.txEventHandlers[eventType]; ok {
+			panic(fmt.Errorf("duplicate tx event handler for type %s", eventType))
+		}
+
+		r.txEventHandlers[eventType] = handler
+		r.txEventPrefixes[eventTypePrefix(eventType)] = true
+	}
+}
+
+func (r *Registry) RegisterBeginBlockHandler(handler EventHandler, eventTypes ...string) {
+	for _, eventType := range eventTypes {
+		if _, ok := r.beginBlockHandlers[eventType]; ok {
+			panic(fmt.Errorf("duplicate begin block handler for type %s", eventType))
+		}
+
+		r.beginBlockHandlers[eventType] = handler
+	}
+}
+
+func (r *Registry) RegisterEndBlockHandler(handler EventHandler, eventTypes ...string) {
+	for _, eventType := range eventTypes {
+		if _, ok := r.endBlockHandlers[eventType]; ok {
+			panic(fmt.Errorf("duplicate end block handler for type %s", eventType))
+		}
+
+		r.endBlockHandlers[eventType] = handler
+	}
+}
+
+func (r *Registry) HandleMsg(c *Context, m *models.Message) ([]types.DatabaseOperation, error) {
+	handler, ok := r.msgHandlers[m.Type]
+	if !ok {
+		reportUnhandledType("Msg", m.Type)
+		return nil, nil
+	}
+
+	return handler(c, m)
+}
+
+// HandleTxEvent reports the unhandled types only of the modules with transaction
+// event handlers, the events of the other modules are consumed by the message
+// handlers instead.
+func (r *Registry) HandleTxEvent(c *Context, e *types.Event) ([]types.DatabaseOperation, error) {
+	handler, ok := r.txEventHandlers[e.Type]
+	if !ok {
+		if r.txEventPrefixes[eventTypePrefix(e.Type)] {
+			reportUnhandledType("TxEvent", e.Type)
+		}
+
+		return nil, nil
+	}
+
+	return handler(c, e)
+}
+
+func (r *Registry) HandleBeginBlockEvent(c *Context, e *types.Event) ([]types.DatabaseOperation, error) {
+	handler, ok := r.beginBlockHandlers[e.Type]
+	if !ok {
+		reportUnhandledType("BeginBlockEvent", e.Type)
+		return nil, nil
+	}
+
+	return handler(c, e)
+}
+
+func (r *Registry) HandleEndBlockEvent(c *Context, e *types.Event) ([]types.DatabaseOperation, error) {
+	handler, ok := r.endBlockHandlers[e.Type]
+	if !ok {
+		reportUnhandledType("EndBlockEvent", e.Type)
+		return nil, nil
+	}
+
+	return handler(c, e)
+}
+
+// eventTypePrefix returns the module and version part of an event type, such
+// as sentinel.node.v3 for sentinel.node.v3.EventPay.
+func eventTypePrefix(s string) string {
+	if i := strings.LastIndex(s, "."); i != -1 {
+		return s[:i]
+	}
+
+	return s
+}
+
+// reportUnhandledType logs the Sentinel types without a registered handler, the
+// types of the other modules are expected to be skipped.
+func reportUnhandledType(kind, s string) {
+	if strings.HasPrefix(strings.TrimPrefix(s, "/"), "sentinel.") {
+		log.Println("UnhandledType", kind, s)
+	}
+}
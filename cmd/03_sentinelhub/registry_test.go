@@ -0,0 +1,172 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+func newTestContext() *Context {
+	return &Context{
+		Block: &models.Block{
+			Height: 100,
+			Time:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		Tx: &models.Tx{
+			Hash: "TXHASH",
+			Result: &models.TxResult{
+				Events: types.Events{
+					{Type: "message", Attributes: map[string]string{"action": "start"}},
+					{Type: "sentinel.session.v2.EventStart", Attributes: map[string]string{"id": "7"}},
+				},
+			},
+		},
+		EIndex: -1,
+	}
+}
+
+func TestNewRegistry(t *testing.T) {
+	for _, v3 := range []bool{false, true} {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Fatalf("newRegistry(%t) panicked: %v", v3, r)
+				}
+			}()
+
+			newRegistry(v3)
+		}()
+	}
+}
+
+func TestRegistryDuplicateHandler(t *testing.T) {
+	r := NewRegistry()
+	r.RegisterEndBlockHandler(handleSessionEventUpdateStatus, "sentinel.session.v2.EventUpdateStatus")
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic on a duplicate handler")
+		}
+	}()
+
+	r.RegisterEndBlockHandler(handleSessionEventUpdateStatus, "sentinel.session.v2.EventUpdateStatus")
+}
+
+func TestRegistryUnhandledType(t *testing.T) {
+	r := NewRegistry()
+	c := newTestContext()
+
+	ops, err := r.HandleMsg(c, &models.Message{Type: "/cosmos.bank.v1beta1.MsgSend"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 0 {
+		t.Fatalf("got %d operations, want 0", len(ops))
+	}
+}
+
+func TestRegistryHandleMsg(t *testing.T) {
+	r := newRegistry(false)
+	c := newTestContext()
+
+	m := &models.Message{
+		Type: "/sentinel.session.v2.MsgStartRequest",
+		Data: bson.M{
+			"from":    "sent1from",
+			"id":      "3",
+			"address": "sentnode1addr",
+		},
+	}
+
+	ops, err := r.HandleMsg(c, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 1 {
+		t.Fatalf("got %d operations, want 1", len(ops))
+	}
+	if c.EIndex != 1 {
+		t.Fatalf("got event index %d, want 1", c.EIndex)
+	}
+}
+
+func TestRegistryHandleTxEventV3Session(t *testing.T) {
+	r := newRegistry(true)
+	c := newTestContext()
+
+	nodeEvent := &types.Event{
+		Type: "sentinel.node.v3.EventCreateSession",
+		Attributes: map[string]string{
+			"session_id":   "9",
+			"acc_address":  "sent1acc",
+			"node_address": "sentnode1addr",
+		},
+	}
+	subscriptionEvent := &types.Event{
+		Type: "sentinel.subscription.v3.EventCreateSession",
+		Attributes: map[string]string{
+			"session_id":      "9",
+			"subscription_id": "4",
+			"acc_address":     "sent1acc",
+			"node_address":    "sentnode1addr",
+		},
+	}
+	c.Tx.Result.Events = append(c.Tx.Result.Events, nodeEvent, subscriptionEvent)
+
+	ops, err := r.HandleTxEvent(c, nodeEvent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 0 {
+		t.Fatalf("got %d operations for the node event, want 0", len(ops))
+	}
+
+	ops, err = r.HandleTxEvent(c, subscriptionEvent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 1 {
+		t.Fatalf("got %d operations for the subscription event, want 1", len(ops))
+	}
+}
+
+func TestRegistryHandleTxEventV3Deposit(t *testing.T) {
+	r := newRegistry(true)
+	c := newTestContext()
+
+	e := &types.Event{
+		Type: "sentinel.deposit.v1.EventAdd",
+		Attributes: map[string]string{
+			"address": "sent1acc",
+			"coins":   "10udvpn",
+		},
+	}
+
+	ops, err := r.HandleTxEvent(c, e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 2 {
+		t.Fatalf("got %d operations, want 2", len(ops))
+	}
+
+	c.Tx.Messages = models.Messages{{Type: "/sentinel.node.v2.MsgSubscribeRequest"}}
+
+	ops, err = r.HandleTxEvent(c, e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ops) != 0 {
+		t.Fatalf("got %d operations with a v2 message, want 0", len(ops))
+	}
+}
+
+func TestEventTypePrefix(t *testing.T) {
+	if s := eventTypePrefix("sentinel.node.v3.EventPay"); s != "sentinel.node.v3" {
+		t.Fatalf("got %s, want sentinel.node.v3", s)
+	}
+}
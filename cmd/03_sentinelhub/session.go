@@ -0,0 +1,139 @@
+package main
+
+import (
+	"time"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/types"
+	sessiontypes "github.com/sentinel-official/explorer/types/session"
+)
+
+func registerSessionHandlers(r *Registry) {
+	r.RegisterMsgHandler(handleSessionMsgStartRequest, "/sentinel.session.v2.MsgStartRequest", "/sentinel.session.v2.MsgService/MsgStart")
+	r.RegisterMsgHandler(handleSessionMsgUpdateDetailsRequest, "/sentinel.session.v2.MsgUpdateDetailsRequest", "/sentinel.session.v2.MsgService/MsgUpdate")
+	r.RegisterMsgHandler(handleSessionMsgEndRequest, "/sentinel.session.v2.MsgEndRequest", "/sentinel.session.v2.MsgService/MsgEnd")
+	r.RegisterEndBlockHandler(handleSessionEventUpdateStatus, "sentinel.session.v2.EventUpdateStatus")
+}
+
+func handleSessionMsgStartRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := sessiontypes.NewMsgStartRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		eventStart *sessiontypes.EventStart
+	)
+
+	c.EIndex, eventStart, err = sessiontypes.NewEventStartFromEvents(c.Tx.Result.Events, c.EIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	dSession := models.Session{
+		ID:              eventStart.ID,
+		SubscriptionID:  msg.ID,
+		AccAddr:         msg.From,
+		NodeAddr:        msg.NodeAddress,
+		Bandwidth:       nil,
+		Duration:        0,
+		Payment:         nil,
+		StakingReward:   nil,
+		Rating:          0,
+		StartHeight:     c.Block.Height,
+		StartTimestamp:  c.Block.Time,
+		StartTxHash:     c.Tx.Hash,
+		EndHeight:       0,
+		EndTimestamp:    time.Time{},
+		EndTxHash:       "",
+		Status:          hubtypes.StatusActive.String(),
+		StatusHeight:    c.Block.Height,
+		StatusTimestamp: c.Block.Time,
+		StatusTxHash:    c.Tx.Hash,
+	}
+
+	ops = append(
+		ops,
+		operations.NewSessionCreate(c.DB, &dSession),
+	)
+
+	return ops, nil
+}
+
+func handleSessionMsgUpdateDetailsRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := sessiontypes.NewMsgUpdateDetailsRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypeSessionUpdateDetails,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.Tx.Hash,
+		SessionID: msg.ID,
+		Bandwidth: msg.Bandwidth,
+		Duration:  msg.Duration,
+	}
+
+	ops = append(
+		ops,
+		operations.NewSessionUpdateDetails(c.DB, msg.ID, msg.Bandwidth, msg.Duration, nil, nil, -1),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handleSessionMsgEndRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := sessiontypes.NewMsgEndRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	status := hubtypes.StatusInactivePending.String()
+	dEvent1 := models.Event{
+		Type:      types.EventTypeSessionUpdateStatus,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.Tx.Hash,
+		SessionID: msg.ID,
+		Status:    status,
+	}
+
+	ops = append(
+		ops,
+		operations.NewSessionUpdateDetails(c.DB, msg.ID, nil, -1, nil, nil, msg.Rating),
+		operations.NewSessionUpdateStatus(c.DB, msg.ID, status, c.Block.Height, c.Block.Time, c.Tx.Hash),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handleSessionEventUpdateStatus(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := sessiontypes.NewEventUpdateStatus(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypeSessionUpdateStatus,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    "",
+		SessionID: event.ID,
+		Status:    event.Status,
+	}
+
+	ops = append(
+		ops,
+		operations.NewSessionUpdateStatus(c.DB, event.ID, event.Status, c.Block.Height, c.Block.Time, ""),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
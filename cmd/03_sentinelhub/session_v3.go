@@ -0,0 +1,63 @@
+package main
+
+import (
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/types"
+	sessiontypes "github.com/sentinel-official/explorer/types/session"
+)
+
+func registerSessionV3Handlers(r *Registry) {
+	r.RegisterTxEventHandler(handleSessionEventUpdateDetailsV3, "sentinel.session.v3.EventUpdateDetails")
+	r.RegisterTxEventHandler(handleSessionEventUpdateStatusV3, "sentinel.session.v3.EventUpdateStatus")
+	r.RegisterEndBlockHandler(handleSessionEventUpdateStatusV3, "sentinel.session.v3.EventUpdateStatus")
+}
+
+func handleSessionEventUpdateDetailsV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := sessiontypes.NewEventUpdateDetailsV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypeSessionUpdateDetails,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.TxHash(),
+		SessionID: event.ID,
+		Bandwidth: event.Bandwidth,
+		Duration:  event.Duration,
+	}
+
+	ops = append(
+		ops,
+		operations.NewSessionUpdateDetails(c.DB, event.ID, event.Bandwidth, event.Duration, nil, nil, -1),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handleSessionEventUpdateStatusV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := sessiontypes.NewEventUpdateStatusV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:      types.EventTypeSessionUpdateStatus,
+		Height:    c.Block.Height,
+		Timestamp: c.Block.Time,
+		TxHash:    c.TxHash(),
+		SessionID: event.ID,
+		Status:    event.Status,
+	}
+
+	ops = append(
+		ops,
+		operations.NewSessionUpdateStatus(c.DB, event.ID, event.Status, c.Block.Height, c.Block.Time, c.TxHash()),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	hubtypes "github.com/sentinel-official/hub/types"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/types"
+	subscriptiontypes "github.com/sentinel-official/explorer/types/subscription"
+)
+
+func registerSubscriptionHandlers(r *Registry) {
+	r.RegisterBeginBlockHandler(handleSubscriptionEventPayForPayout, "sentinel.subscription.v2.EventPayForPayout")
+	r.RegisterMsgHandler(handleSubscriptionMsgCancelRequest, "/sentinel.subscription.v2.MsgCancelRequest", "/sentinel.subscription.v2.MsgService/MsgCancel")
+	r.RegisterMsgHandler(handleSubscriptionMsgAllocateRequest, "/sentinel.subscription.v2.MsgAllocateRequest", "/sentinel.subscription.v2.MsgService/MsgAllocate")
+	r.RegisterEndBlockHandler(handleSubscriptionEventPayForSession, "sentinel.subscription.v2.EventPayForSession")
+	r.RegisterEndBlockHandler(handleSubscriptionEventUpdateStatus, "sentinel.subscription.v2.EventUpdateStatus")
+	r.RegisterEndBlockHandler(handleSubscriptionEventRefund, "sentinel.subscription.v2.EventRefund")
+	r.RegisterEndBlockHandler(handleSubscriptionEventAllocate, "sentinel.subscription.v2.EventAllocate")
+}
+
+func handleSubscriptionEventPayForPayout(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := subscriptiontypes.NewEventPayForPayout(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dSubscriptionPayout := models.SubscriptionPayout{
+		ID:            event.ID,
+		AccAddr:       event.Address,
+		NodeAddr:      event.NodeAddress,
+		Payment:       event.Payment,
+		StakingReward: event.StakingReward,
+		Height:        c.Block.Height,
+		Timestamp:     c.Block.Time,
+		TxHash:        "",
+	}
+
+	ops = append(
+		ops,
+		operations.NewSubscriptionPayoutCreate(c.DB, &dSubscriptionPayout),
+	)
+
+	return ops, nil
+}
+
+func handleSubscriptionMsgCancelRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	msg, err := subscriptiontypes.NewMsgCancelRequest(m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	status := hubtypes.StatusInactivePending.String()
+	dEvent1 := models.Event{
+		Type:           types.EventTypeSubscriptionUpdateStatus,
+		Height:         c.Block.Height,
+		Timestamp:      c.Block.Time,
+		TxHash:         c.Tx.Hash,
+		SubscriptionID: msg.ID,
+		Status:         status,
+	}
+
+	ops = append(
+		ops,
+		operations.NewSubscriptionUpdateStatus(c.DB, msg.ID, status, c.Block.Height, c.Block.Time, c.Tx.Hash),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handleSubscriptionMsgAllocateRequest(c *Context, m *models.Message) (ops []types.DatabaseOperation, err error) {
+	var (
+		eventAllocate1 *subscriptiontypes.EventAllocate
+		eventAllocate2 *subscriptiontypes.EventAllocate
+	)
+
+	c.EIndex, eventAllocate1, err = subscriptiontypes.NewEventAllocateFromEvents(c.Tx.Result.Events, c.EIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:           types.EventTypeSubscriptionAllocationUpdateDetails,
+		Height:         c.Block.Height,
+		Timestamp:      c.Block.Time,
+		TxHash:         c.Tx.Hash,
+		SubscriptionID: eventAllocate1.ID,
+		AccAddr:        eventAllocate1.Address,
+		GrantedBytes:   eventAllocate1.GrantedBytes,
+		UtilisedBytes:  eventAllocate1.UtilisedBytes,
+	}
+
+	c.EIndex, eventAllocate2, err = subscriptiontypes.NewEventAllocateFromEvents(c.Tx.Result.Events, c.EIndex+1)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent2 := models.Event{
+		Type:           types.EventTypeSubscriptionAllocationUpdateDetails,
+		Height:         c.Block.Height,
+		Timestamp:      c.Block.Time,
+		TxHash:         c.Tx.Hash,
+		SubscriptionID: eventAllocate1.ID,
+		AccAddr:        eventAllocate1.Address,
+		GrantedBytes:   eventAllocate1.GrantedBytes,
+		UtilisedBytes:  eventAllocate1.UtilisedBytes,
+	}
+
+	ops = append(
+		ops,
+		operations.NewSubscriptionAllocationUpdate(c.DB, eventAllocate1.ID, eventAllocate1.Address, eventAllocate1.GrantedBytes, eventAllocate1.UtilisedBytes),
+		operations.NewEventCreate(c.DB, &dEvent1),
+		operations.NewSubscriptionAllocationUpdate(c.DB, eventAllocate2.ID, eventAllocate2.Address, eventAllocate2.GrantedBytes, eventAllocate2.UtilisedBytes),
+		operations.NewEventCreate(c.DB, &dEvent2),
+	)
+
+	return ops, nil
+}
+
+func handleSubscriptionEventPayForSession(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := subscriptiontypes.NewEventPayForSession(e)
+	if err != nil {
+		return nil, err
+	}
+
+	ops = append(
+		ops,
+		operations.NewSessionUpdateDetails(c.DB, event.ID, nil, -1, event.Payment, event.StakingReward, -1),
+	)
+
+	return ops, nil
+}
+
+func handleSubscriptionEventUpdateStatus(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := subscriptiontypes.NewEventUpdateStatus(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:           types.EventTypeSubscriptionUpdateStatus,
+		Height:         c.Block.Height,
+		Timestamp:      c.Block.Time,
+		TxHash:         "",
+		SubscriptionID: event.ID,
+		Status:         event.Status,
+	}
+
+	ops = append(
+		ops,
+		operations.NewSubscriptionUpdateStatus(c.DB, event.ID, event.Status, c.Block.Height, c.Block.Time, ""),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handleSubscriptionEventRefund(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := subscriptiontypes.NewEventRefund(e)
+	if err != nil {
+		return nil, err
+	}
+
+	ops = append(
+		ops,
+		operations.NewSubscriptionUpdateDetails(c.DB, event.ID, event.Amount),
+	)
+
+	return ops, nil
+}
+
+func handleSubscriptionEventAllocate(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := subscriptiontypes.NewEventAllocate(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:           types.EventTypeSubscriptionAllocationUpdateDetails,
+		Height:         c.Block.Height,
+		Timestamp:      c.Block.Time,
+		TxHash:         "",
+		SubscriptionID: event.ID,
+		AccAddr:        event.Address,
+		GrantedBytes:   event.GrantedBytes,
+		UtilisedBytes:  event.UtilisedBytes,
+	}
+
+	ops = append(
+		ops,
+		operations.NewSubscriptionAllocationUpdate(c.DB, event.ID, event.Address, event.GrantedBytes, event.UtilisedBytes),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"time"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/operations"
+	"github.com/sentinel-official/explorer/types"
+	subscriptiontypes "github.com/sentinel-official/explorer/types/subscription"
+)
+
+func registerSubscriptionV3Handlers(r *Registry) {
+	r.RegisterTxEventHandler(handleSubscriptionEventCreateV3, "sentinel.subscription.v3.EventCreate")
+	r.RegisterTxEventHandler(handleSubscriptionEventUpdateV3, "sentinel.subscription.v3.EventUpdate")
+	r.RegisterTxEventHandler(handleSubscriptionEventAllocateV3, "sentinel.subscription.v3.EventAllocate")
+	r.RegisterTxEventHandler(handleSubscriptionEventCreateSessionV3, "sentinel.subscription.v3.EventCreateSession")
+	r.RegisterTxEventHandler(handleSubscriptionEventPayV3, "sentinel.subscription.v3.EventPay")
+	r.RegisterEndBlockHandler(handleSubscriptionEventUpdateV3, "sentinel.subscription.v3.EventUpdate")
+	r.RegisterEndBlockHandler(handleSubscriptionEventPayV3, "sentinel.subscription.v3.EventPay")
+	r.RegisterEndBlockHandler(handleSubscriptionEventAllocateV3, "sentinel.subscription.v3.EventAllocate")
+}
+
+func handleSubscriptionEventCreateV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := subscriptiontypes.NewEventCreateV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dSubscription := models.Subscription{
+		ID:              event.ID,
+		AccAddr:         event.AccAddress,
+		PlanID:          event.PlanID,
+		Price:           event.Price,
+		StartHeight:     c.Block.Height,
+		StartTimestamp:  c.Block.Time,
+		StartTxHash:     c.TxHash(),
+		EndHeight:       0,
+		EndTimestamp:    time.Time{},
+		EndTxHash:       "",
+		Status:          hubtypes.StatusActive.String(),
+		StatusHeight:    c.Block.Height,
+		StatusTimestamp: c.Block.Time,
+		StatusTxHash:    c.TxHash(),
+	}
+
+	ops = append(
+		ops,
+		operations.NewSubscriptionCreate(c.DB, &dSubscription),
+	)
+
+	return ops, nil
+}
+
+// handleSubscriptionEventUpdateV3 indexes only the status, the v3 event is
+// emitted for the renewal policy updates as well.
+func handleSubscriptionEventUpdateV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := subscriptiontypes.NewEventUpdateV3(e)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status == "" {
+		return nil, nil
+	}
+
+	dEvent1 := models.Event{
+		Type:           types.EventTypeSubscriptionUpdateStatus,
+		Height:         c.Block.Height,
+		Timestamp:      c.Block.Time,
+		TxHash:         c.TxHash(),
+		SubscriptionID: event.ID,
+		Status:         event.Status,
+	}
+
+	ops = append(
+		ops,
+		operations.NewSubscriptionUpdateStatus(c.DB, event.ID, event.Status, c.Block.Height, c.Block.Time, c.TxHash()),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handleSubscriptionEventAllocateV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := subscriptiontypes.NewEventAllocateV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dEvent1 := models.Event{
+		Type:           types.EventTypeSubscriptionAllocationUpdateDetails,
+		Height:         c.Block.Height,
+		Timestamp:      c.Block.Time,
+		TxHash:         c.TxHash(),
+		SubscriptionID: event.ID,
+		AccAddr:        event.AccAddress,
+		GrantedBytes:   event.GrantedBytes,
+		UtilisedBytes:  event.UtilisedBytes,
+	}
+
+	ops = append(
+		ops,
+		operations.NewSubscriptionAllocationUpdate(c.DB, event.ID, event.AccAddress, event.GrantedBytes, event.UtilisedBytes),
+		operations.NewEventCreate(c.DB, &dEvent1),
+	)
+
+	return ops, nil
+}
+
+func handleSubscriptionEventCreateSessionV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := subscriptiontypes.NewEventCreateSessionV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	dSession := models.Session{
+		ID:              event.ID,
+		SubscriptionID:  event.SubscriptionID,
+		AccAddr:         event.AccAddress,
+		NodeAddr:        event.NodeAddress,
+		StartHeight:     c.Block.Height,
+		StartTimestamp:  c.Block.Time,
+		StartTxHash:     c.TxHash(),
+		EndHeight:       0,
+		EndTimestamp:    time.Time{},
+		EndTxHash:       "",
+		Status:          hubtypes.StatusActive.String(),
+		StatusHeight:    c.Block.Height,
+		StatusTimestamp: c.Block.Time,
+		StatusTxHash:    c.TxHash(),
+	}
+
+	ops = append(
+		ops,
+		operations.NewSessionCreate(c.DB, &dSession),
+	)
+
+	return ops, nil
+}
+
+// handleSubscriptionEventPayV3 adds the payment to the totals of the
+// subscription, the event is emitted on every renewal of it.
+func handleSubscriptionEventPayV3(c *Context, e *types.Event) (ops []types.DatabaseOperation, err error) {
+	event, err := subscriptiontypes.NewEventPayV3(e)
+	if err != nil {
+		return nil, err
+	}
+
+	ops = append(
+		ops,
+		operations.NewSubscriptionPaymentAdd(c.DB, event.ID, event.Payment, event.StakingReward),
+	)
+
+	return ops, nil
+}
@@ -17,6 +17,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/migrations"
 	"github.com/sentinel-official/explorer/utils"
 )
 
@@ -44,36 +45,7 @@ func init() {
 }
 
 func createIndexes(ctx context.Context, db *mongo.Database) error {
-	indexes := []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "type", Value: 1},
-				bson.E{Key: "timestamp", Value: -1},
-			},
-		},
-	}
-
-	_, err := database.EventIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	indexes = []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "id", Value: 1},
-			},
-			Options: options.Index().
-				SetUnique(true),
-		},
-	}
-
-	_, err = database.SessionIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return migrations.CreateIndexes(ctx, db, database.EventCollectionName, database.SessionCollectionName)
 }
 
 func main() {
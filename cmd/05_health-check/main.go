@@ -12,6 +12,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/migrations"
 	nodetypes "github.com/sentinel-official/explorer/types/node"
 	"github.com/sentinel-official/explorer/utils"
 )
@@ -38,28 +39,7 @@ func init() {
 }
 
 func createIndexes(ctx context.Context, db *mongo.Database) error {
-	indexes := []mongo.IndexModel{
-		{
-			Keys: bson.D{
-				bson.E{Key: "addr", Value: 1},
-			},
-			Options: options.Index().
-				SetUnique(true),
-		},
-		{
-			Keys: bson.D{
-				bson.E{Key: "status", Value: 1},
-				bson.E{Key: "remote_url", Value: 1},
-			},
-		},
-	}
-
-	_, err := database.NodeIndexesCreateMany(ctx, db, indexes)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return migrations.CreateIndexes(ctx, db, database.NodeCollectionName)
 }
 
 func main() {
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"sort"
+	"time"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types/coingecko"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+var (
+	timeframes = map[string]func(time.Time) time.Time{
+		"hour": utils.HourDate,
+		"day":  utils.DayDate,
+	}
+)
+
+// PricesFromPoints groups the points into candles of the given timeframe. The points are expected
+// to be sorted by timestamp in ascending order.
+func PricesFromPoints(timeframe, quote string, points []*coingecko.Point) []*models.Price {
+	truncate := timeframes[timeframe]
+
+	m := make(map[time.Time]*models.Price)
+	for _, point := range points {
+		t := truncate(point.Timestamp)
+
+		item, ok := m[t]
+		if !ok {
+			item = &models.Price{
+				CoinID:    coinID,
+				Denom:     denom,
+				Exponent:  exponent,
+				Quote:     quote,
+				Timeframe: timeframe,
+				Timestamp: t,
+				Open:      point.Value,
+				High:      point.Value,
+				Low:       point.Value,
+			}
+			m[t] = item
+		}
+
+		if point.Value > item.High {
+			item.High = point.Value
+		}
+		if point.Value < item.Low {
+			item.Low = point.Value
+		}
+
+		item.Close = point.Value
+	}
+
+	result := make([]*models.Price, 0, len(m))
+	for _, item := range m {
+		result = append(result, item)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Timestamp.Before(result[j].Timestamp)
+	})
+
+	return result
+}
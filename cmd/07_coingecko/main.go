@@ -1,7 +1,258 @@
 package main
 
+import (
+	"context"
+	"flag"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/migrations"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types/coingecko"
+	"github.com/sentinel-official/explorer/utils"
+)
+
 const appName = "07_coingecko"
 
+// The market_chart/range endpoint returns hourly prices for ranges of up to 90 days.
+const maxRangeDuration = 90 * 24 * time.Hour
+
+var (
+	dbAddress       string
+	dbName          string
+	dbUsername      string
+	dbPassword      string
+	apiURL          string
+	apiKey          string
+	apiKeyHeader    string
+	coinID          string
+	denom           string
+	exponent        int64
+	quoteCurrencies string
+	fromTimestamp   string
+	requestInterval time.Duration
+	interval        time.Duration
+	timeout         time.Duration
+)
+
+func init() {
+	log.SetFlags(0)
+
+	flag.StringVar(&dbAddress, "db-address", "mongodb://127.0.0.1:27017", "")
+	flag.StringVar(&dbName, "db-name", "sentinelhub-2", "")
+	flag.StringVar(&dbUsername, "db-username", "", "")
+	flag.StringVar(&dbPassword, "db-password", "", "")
+	flag.StringVar(&apiURL, "api-url", "https://api.coingecko.com/api/v3", "")
+	flag.StringVar(&apiKey, "api-key", "", "")
+	flag.StringVar(&apiKeyHeader, "api-key-header", "x-cg-demo-api-key", "")
+	flag.StringVar(&coinID, "coin-id", "sentinel", "")
+	flag.StringVar(&denom, "denom", "udvpn", "")
+	flag.Int64Var(&exponent, "exponent", 6, "")
+	flag.StringVar(&quoteCurrencies, "quote-currencies", "usd", "")
+	flag.StringVar(&fromTimestamp, "from-timestamp", "2021-03-27T00:00:00Z", "")
+	flag.DurationVar(&requestInterval, "request-interval", 5*time.Second, "")
+	flag.DurationVar(&interval, "interval", time.Hour, "")
+	flag.DurationVar(&timeout, "timeout", 30*time.Second, "")
+}
+
+func createIndexes(ctx context.Context, db *mongo.Database) error {
+	return migrations.CreateIndexes(ctx, db, database.PriceCollectionName)
+}
+
+// startTimestamp returns the day from which the prices of the quote currency have to be fetched.
+// The last stored day is fetched again since its candles may have been built from partial data.
+func startTimestamp(ctx context.Context, db *mongo.Database, quote string, minTimestamp time.Time) (time.Time, error) {
+	var result time.Time
+	for timeframe := range timeframes {
+		filter := bson.M{
+			"denom":     denom,
+			"quote":     quote,
+			"timeframe": timeframe,
+		}
+		projection := bson.M{
+			"_id":       0,
+			"timestamp": 1,
+		}
+		opts := options.FindOne().
+			SetProjection(projection).
+			SetSort(bson.D{bson.E{Key: "timestamp", Value: -1}})
+
+		item, err := database.PriceFindOne(ctx, db, filter, opts)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if item == nil {
+			return minTimestamp, nil
+		}
+
+		if result.IsZero() || item.Timestamp.Before(result) {
+			result = item.Timestamp
+		}
+	}
+
+	if result.Before(minTimestamp) {
+		return minTimestamp, nil
+	}
+
+	return utils.DayDate(result), nil
+}
+
+// priceWriteModel returns the upsert of a candle, which is merged with the stored one instead of
+// replacing it. A candle straddling two fetched ranges is built from the points of both, so the
+// open of the first range is kept, the low and the high are the extremes of both and the close is
+// the one of the last range.
+func priceWriteModel(item *models.Price) mongo.WriteModel {
+	filter := bson.M{
+		"denom":     item.Denom,
+		"quote":     item.Quote,
+		"timeframe": item.Timeframe,
+		"timestamp": item.Timestamp,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"coin_id":  item.CoinID,
+			"exponent": item.Exponent,
+			"close":    item.Close,
+		},
+		"$setOnInsert": bson.M{
+			"open": item.Open,
+		},
+		"$min": bson.M{
+			"low": item.Low,
+		},
+		"$max": bson.M{
+			"high": item.High,
+		},
+	}
+
+	return mongo.NewUpdateOneModel().
+		SetFilter(filter).
+		SetUpdate(update).
+		SetUpsert(true)
+}
+
+func upsertPrices(ctx context.Context, db *mongo.Database, items []*models.Price) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	var writeModels []mongo.WriteModel
+	for _, item := range items {
+		writeModels = append(writeModels, priceWriteModel(item))
+	}
+
+	opts := options.BulkWrite().
+		SetOrdered(false)
+
+	if _, err := database.PriceBulkWrite(ctx, db, writeModels, opts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// fetchPrices returns the candles of every timeframe built from the points of the range.
+func fetchPrices(quote string, from, to time.Time) ([]*models.Price, error) {
+	chart, err := coingecko.FetchMarketChartRange(apiURL, apiKeyHeader, apiKey, coinID, quote, from, to, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	points := chart.Prices
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].Timestamp.Before(points[j].Timestamp)
+	})
+
+	log.Println("PointsLen", len(points))
+
+	var items []*models.Price
+	for timeframe := range timeframes {
+		items = append(items, PricesFromPoints(timeframe, quote, points)...)
+	}
+
+	return items, nil
+}
+
+func run(ctx context.Context, db *mongo.Database, quote string, minTimestamp time.Time) error {
+	from, err := startTimestamp(ctx, db, quote, minTimestamp)
+	if err != nil {
+		return err
+	}
+
+	for now := time.Now().UTC(); from.Before(now); {
+		to := from.Add(maxRangeDuration)
+		if to.After(now) {
+			to = now
+		}
+
+		log.Println("Quote", quote, "From", from, "To", to)
+
+		items, err := fetchPrices(quote, from, to)
+		if err != nil {
+			return err
+		}
+
+		if err := upsertPrices(ctx, db, items); err != nil {
+			return err
+		}
+
+		from = to
+		if from.Before(now) {
+			time.Sleep(requestInterval)
+		}
+	}
+
+	return nil
+}
+
 func main() {
+	flag.Parse()
+
+	db, err := utils.PrepareDatabase(context.TODO(), appName, dbUsername, dbPassword, dbAddress, dbName)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if err := db.Client().Ping(context.TODO(), nil); err != nil {
+		log.Fatalln(err)
+	}
+
+	if err := createIndexes(context.TODO(), db); err != nil {
+		log.Fatalln(err)
+	}
+
+	minTimestamp, err := time.Parse(time.RFC3339, fromTimestamp)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	minTimestamp = utils.DayDate(minTimestamp.UTC())
+
+	// The prices are fetched again every interval, a zero interval fetches them only once.
+	for {
+		for _, quote := range strings.Split(quoteCurrencies, ",") {
+			quote = strings.ToLower(strings.TrimSpace(quote))
+			if quote == "" {
+				continue
+			}
+
+			if err := run(context.TODO(), db, quote, minTimestamp); err != nil {
+				log.Fatalln(err)
+			}
+		}
+
+		if interval <= 0 {
+			return
+		}
 
+		log.Println("Sleeping", interval)
+		time.Sleep(interval)
+	}
 }
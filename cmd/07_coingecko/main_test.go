@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/models"
+)
+
+func TestFetchPrices(t *testing.T) {
+	day := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/coins/sentinel/market_chart/range" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.URL.Query().Get("vs_currency") != "usd" {
+			http.Error(w, "invalid vs_currency", http.StatusBadRequest)
+			return
+		}
+
+		// The points are served out of order, with two of them within the first hour.
+		_, _ = fmt.Fprintf(
+			w, `{"prices":[[%d,3],[%d,1],[%d,4],[%d,2]]}`,
+			day.Add(2*time.Hour).UnixMilli(),
+			day.Add(10*time.Minute).UnixMilli(),
+			day.Add(20*time.Minute).UnixMilli(),
+			day.Add(time.Hour).UnixMilli(),
+		)
+	}))
+	defer srv.Close()
+
+	apiURL = srv.URL
+
+	items, err := fetchPrices("usd", day, day.Add(24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := make(map[string][]*models.Price)
+	for _, item := range items {
+		m[item.Timeframe] = append(m[item.Timeframe], item)
+	}
+
+	if len(m["hour"]) != 3 {
+		t.Fatalf("got %d hour candles, want 3", len(m["hour"]))
+	}
+	if c := m["hour"][0]; c.Open != 1 || c.High != 4 || c.Low != 1 || c.Close != 4 {
+		t.Fatalf("got first hour candle %v", c)
+	}
+
+	if len(m["day"]) != 1 {
+		t.Fatalf("got %d day candles, want 1", len(m["day"]))
+	}
+	if c := m["day"][0]; c.Open != 1 || c.High != 4 || c.Low != 1 || c.Close != 3 || !c.Timestamp.Equal(day) {
+		t.Fatalf("got day candle %v", c)
+	}
+}
+
+func TestFetchPricesStatusCode(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	apiURL = srv.URL
+
+	if _, err := fetchPrices("usd", time.Now().Add(-time.Hour), time.Now()); err == nil {
+		t.Fatal("expected an error on an unexpected status code")
+	}
+}
+
+func TestPriceWriteModel(t *testing.T) {
+	item := &models.Price{
+		Denom:     "udvpn",
+		Quote:     "usd",
+		Timeframe: "day",
+		Timestamp: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		Open:      1,
+		High:      4,
+		Low:       0.5,
+		Close:     3,
+	}
+
+	model, ok := priceWriteModel(item).(*mongo.UpdateOneModel)
+	if !ok {
+		t.Fatal("expected an update one model")
+	}
+
+	update := model.Update.(bson.M)
+	if v := update["$setOnInsert"].(bson.M)["open"]; v != item.Open {
+		t.Fatalf("got open %v, want it set on insert only", v)
+	}
+	if v := update["$min"].(bson.M)["low"]; v != item.Low {
+		t.Fatalf("got low %v, want it merged with $min", v)
+	}
+	if v := update["$max"].(bson.M)["high"]; v != item.High {
+		t.Fatalf("got high %v, want it merged with $max", v)
+	}
+	if v := update["$set"].(bson.M)["close"]; v != item.Close {
+		t.Fatalf("got close %v, want it set", v)
+	}
+	if _, ok := update["$set"].(bson.M)["open"]; ok {
+		t.Fatal("expected open not to be overwritten")
+	}
+}
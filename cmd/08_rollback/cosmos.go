@@ -0,0 +1,375 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/querier"
+	"github.com/sentinel-official/explorer/types"
+	govtypes "github.com/sentinel-official/explorer/types/gov"
+)
+
+func rollbackAccounts(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"create_height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	return database.AccountDeleteMany(ctx, db, filter)
+}
+
+func rollbackUnbondings(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"start_height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	if err := database.UnbondingDeleteMany(ctx, db, filter); err != nil {
+		return err
+	}
+
+	filter = bson.M{
+		"end_height": bson.M{
+			"$gt": height,
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"end_height":    0,
+			"end_timestamp": time.Time{},
+			"status":        hubtypes.StatusActive.String(),
+		},
+	}
+
+	if _, err := database.UnbondingUpdateMany(ctx, db, filter, update); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func rollbackRedelegations(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"start_height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	if err := database.RedelegationDeleteMany(ctx, db, filter); err != nil {
+		return err
+	}
+
+	filter = bson.M{
+		"end_height": bson.M{
+			"$gt": height,
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"end_height":    0,
+			"end_timestamp": time.Time{},
+			"status":        hubtypes.StatusActive.String(),
+		},
+	}
+
+	if _, err := database.RedelegationUpdateMany(ctx, db, filter, update); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkDelegations fails when delegations changed above the height and no RPC address is given.
+// Their amounts are accumulated without keeping a history, so they can only be rebuilt from the
+// state at the height, and 02_cosmos-sdk must not be rewound without them being rebuilt.
+func checkDelegations(ctx context.Context, db *mongo.Database, height int64) error {
+	if rpcAddress != "" {
+		return nil
+	}
+
+	filter := bson.M{
+		"height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	count, err := database.DelegationCountDocuments(ctx, db, filter)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("%d delegations changed above the height, rpc-address is required to rebuild them", count)
+	}
+
+	return nil
+}
+
+// rollbackDelegations sets the delegations changed above the height to their amounts in the state
+// at the height, and removes the ones which did not exist at it.
+func rollbackDelegations(ctx context.Context, db *mongo.Database, q *querier.Querier, height int64) error {
+	filter := bson.M{
+		"height": bson.M{
+			"$gt": height,
+		},
+	}
+	projection := bson.M{
+		"_id":      0,
+		"acc_addr": 1,
+		"val_addr": 1,
+	}
+
+	dDelegations, err := database.DelegationFind(ctx, db, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		return err
+	}
+
+	log.Println("DelegationsLen", len(dDelegations))
+	if len(dDelegations) == 0 {
+		return nil
+	}
+
+	filter = bson.M{
+		"height": height,
+	}
+	projection = bson.M{
+		"_id":  0,
+		"time": 1,
+	}
+
+	dBlock, err := database.BlockFindOne(ctx, db, filter, options.FindOne().SetProjection(projection))
+	if err != nil {
+		return err
+	}
+	if dBlock == nil {
+		return fmt.Errorf("block %d does not exist", height)
+	}
+
+	balances := make(map[string]map[string]*types.Coin)
+	for _, dDelegation := range dDelegations {
+		items, ok := balances[dDelegation.AccAddr]
+		if !ok {
+			res, err := q.QueryDelegatorDelegations(querier.ContextWithHeight(ctx, height), dDelegation.AccAddr, pageLimit)
+			if err != nil {
+				return err
+			}
+
+			items = make(map[string]*types.Coin)
+			for _, item := range res {
+				items[item.Delegation.ValidatorAddress] = types.NewCoin(&item.Balance)
+			}
+
+			balances[dDelegation.AccAddr] = items
+		}
+
+		filter := bson.M{
+			"acc_addr": dDelegation.AccAddr,
+			"val_addr": dDelegation.ValAddr,
+		}
+
+		amount, ok := items[dDelegation.ValAddr]
+		if !ok {
+			if err := database.DelegationDeleteMany(ctx, db, filter); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		update := bson.M{
+			"$set": bson.M{
+				"amount":    amount,
+				"height":    height,
+				"timestamp": dBlock.Time,
+				"tx_hash":   "",
+			},
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+
+		if _, err := database.DelegationFindOneAndUpdate(ctx, db, filter, update, options.FindOneAndUpdate().SetProjection(projection)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rollbackProposals removes the proposals submitted above the height and rebuilds the total
+// deposit, voting period and status of the others from the deposits at or below it.
+func rollbackProposals(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"submit_height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	if err := database.ProposalDeleteMany(ctx, db, filter); err != nil {
+		return err
+	}
+
+	filter = bson.M{
+		"height": bson.M{
+			"$gt": height,
+		},
+	}
+	projection := bson.M{
+		"_id":         0,
+		"proposal_id": 1,
+	}
+
+	dDeposits, err := database.ProposalDepositFind(ctx, db, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		return err
+	}
+
+	if err := database.ProposalDepositDeleteMany(ctx, db, filter); err != nil {
+		return err
+	}
+
+	ids := bson.A{}
+	for _, item := range dDeposits {
+		ids = append(ids, item.ProposalID)
+	}
+
+	filter = bson.M{
+		"$or": bson.A{
+			bson.M{"id": bson.M{"$in": ids}},
+			bson.M{"voting_start_height": bson.M{"$gt": height}},
+			bson.M{"status_height": bson.M{"$gt": height}},
+		},
+	}
+
+	dProposals, err := database.ProposalFind(ctx, db, filter)
+	if err != nil {
+		return err
+	}
+
+	log.Println("ProposalsLen", len(dProposals))
+	for _, dProposal := range dProposals {
+		filter := bson.M{
+			"proposal_id": dProposal.ID,
+		}
+		projection := bson.M{
+			"_id":   0,
+			"coins": 1,
+		}
+
+		dDeposits, err := database.ProposalDepositFind(ctx, db, filter, options.Find().SetProjection(projection))
+		if err != nil {
+			return err
+		}
+
+		var totalDeposit types.Coins
+		for _, item := range dDeposits {
+			totalDeposit = totalDeposit.Add(item.Coins...)
+		}
+
+		updateSet := bson.M{
+			"total_deposit": totalDeposit,
+		}
+		if dProposal.VotingStartHeight > height {
+			dProposal.VotingStartHeight = 0
+			updateSet["voting_start_height"] = int64(0)
+			updateSet["voting_start_timestamp"] = time.Time{}
+			updateSet["voting_start_tx_hash"] = ""
+		}
+		if dProposal.StatusHeight > height {
+			updateSet["final_tally_result"] = nil
+			if dProposal.VotingStartHeight > 0 {
+				updateSet["status"] = govtypes.StatusVotingPeriod
+				updateSet["status_height"] = dProposal.VotingStartHeight
+				updateSet["status_timestamp"] = dProposal.VotingStartTimestamp
+			} else {
+				updateSet["status"] = govtypes.StatusDepositPeriod
+				updateSet["status_height"] = dProposal.SubmitHeight
+				updateSet["status_timestamp"] = dProposal.SubmitTimestamp
+			}
+		}
+
+		filter = bson.M{
+			"id": dProposal.ID,
+		}
+		update := bson.M{
+			"$set": updateSet,
+		}
+		projection = bson.M{
+			"_id": 1,
+		}
+
+		_, err = database.ProposalFindOneAndUpdate(ctx, db, filter, update, options.FindOneAndUpdate().SetProjection(projection))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rollbackProposalVotes removes the votes cast above the height. A vote replaces the earlier one
+// of the same voter, so those earlier votes are lost and are only reported here.
+func rollbackProposalVotes(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	count, err := database.ProposalVoteCountDocuments(ctx, db, filter)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		log.Println("ProposalVotesNotRebuilt", count)
+	}
+
+	return database.ProposalVoteDeleteMany(ctx, db, filter)
+}
+
+// rollbackIBCTransfers removes the transfers started above the height and moves the outgoing ones
+// which were acknowledged or timed out above it back to pending.
+func rollbackIBCTransfers(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	if err := database.IBCTransferDeleteMany(ctx, db, filter); err != nil {
+		return err
+	}
+
+	filter = bson.M{
+		"direction": models.IBCTransferDirectionOutgoing,
+		"status_height": bson.M{
+			"$gt": height,
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":           models.IBCTransferStatusPending,
+			"status_error":     "",
+			"status_height":    int64(0),
+			"status_timestamp": time.Time{},
+			"status_tx_hash":   "",
+		},
+	}
+
+	if _, err := database.IBCTransferUpdateMany(ctx, db, filter, update); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+)
+
+// findEvents returns the events matching the filter at or below the height, in the order in which
+// they were indexed.
+func findEvents(ctx context.Context, db *mongo.Database, filter bson.M, height int64) ([]*models.Event, error) {
+	filter["height"] = bson.M{
+		"$lte": height,
+	}
+
+	opts := options.Find().
+		SetSort(
+			bson.D{
+				bson.E{Key: "height", Value: 1},
+				bson.E{Key: "_id", Value: 1},
+			},
+		)
+
+	return database.EventFind(ctx, db, filter, opts)
+}
+
+// affectedValues returns the distinct values of the field among the events of the given types above
+// the height, i.e. the keys of the entities which were modified after it.
+func affectedValues(ctx context.Context, db *mongo.Database, fieldName string, eventTypes []string, height int64) (bson.A, error) {
+	filter := bson.M{
+		"type": bson.M{
+			"$in": eventTypes,
+		},
+		"height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	return database.EventDistinct(ctx, db, fieldName, filter)
+}
+
+func uint64FromValue(v interface{}) uint64 {
+	switch v := v.(type) {
+	case int32:
+		return uint64(v)
+	case int64:
+		return uint64(v)
+	case float64:
+		return uint64(v)
+	default:
+		return 0
+	}
+}
+
+// findTxMessages returns the messages of the transaction with the given hash, including the ones
+// wrapped by authz.
+func findTxMessages(ctx context.Context, db *mongo.Database, txHash string) (models.Messages, error) {
+	if txHash == "" {
+		return nil, nil
+	}
+
+	filter := bson.M{
+		"hash": txHash,
+	}
+	projection := bson.M{
+		"_id":      0,
+		"messages": 1,
+	}
+
+	item, err := database.TxFindOne(ctx, db, filter, options.FindOne().SetProjection(projection))
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, nil
+	}
+
+	return item.Messages.WithAuthzMsgExecMessages(), nil
+}
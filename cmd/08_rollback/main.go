@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"strings"
+
+	"github.com/sentinel-official/hub/app"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/querier"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const appName = "08_rollback"
+
+const pageLimit = 100
+
+var (
+	toHeight   int64
+	rpcAddress string
+	dbAddress  string
+	dbName     string
+	dbUsername string
+	dbPassword string
+)
+
+func init() {
+	log.SetFlags(0)
+
+	flag.Int64Var(&toHeight, "to-height", 0, "")
+	flag.StringVar(&rpcAddress, "rpc-address", "", "")
+	flag.StringVar(&dbAddress, "db-address", "mongodb://127.0.0.1:27017", "")
+	flag.StringVar(&dbName, "db-name", "sentinelhub-2", "")
+	flag.StringVar(&dbUsername, "db-username", "", "")
+	flag.StringVar(&dbPassword, "db-password", "", "")
+}
+
+// rollbackSyncStatuses moves every app which is ahead of the height back to it, so that the
+// indexers continue from the next height once they are restarted.
+func rollbackSyncStatuses(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"height": bson.M{
+			"$gt": height,
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"height": height,
+		},
+	}
+
+	result, err := database.SyncStatusUpdateMany(ctx, db, filter, update)
+	if err != nil {
+		return err
+	}
+
+	log.Println("SyncStatusesModified", result.ModifiedCount)
+	return nil
+}
+
+// deleteAboveHeight removes the documents which were written by the blocks above the height.
+// The events are removed last, since the other steps depend on them to find the affected entities.
+// The step itself runs last, as the validators step also depends on the signatures of the blocks.
+func deleteAboveHeight(ctx context.Context, db *mongo.Database, height int64) error {
+	funcs := []func(context.Context, *mongo.Database, bson.M, ...*options.DeleteOptions) error{
+		database.BlockDeleteMany,
+		database.TxDeleteMany,
+		database.TxFailureDeleteMany,
+		database.SubscriptionPayoutDeleteMany,
+		database.TransferDeleteMany,
+		database.RewardWithdrawalDeleteMany,
+		database.EventDeleteMany,
+	}
+
+	filter := bson.M{
+		"height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	for _, fn := range funcs {
+		if err := fn(ctx, db, filter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func main() {
+	flag.Parse()
+
+	if toHeight <= 0 {
+		log.Fatalln("to-height must be greater than zero")
+	}
+
+	db, err := utils.PrepareDatabase(context.TODO(), appName, dbUsername, dbPassword, dbAddress, dbName)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if err := db.Client().Ping(context.TODO(), nil); err != nil {
+		log.Fatalln(err)
+	}
+
+	var q *querier.Querier
+	if rpcAddress != "" {
+		encCfg := app.DefaultEncodingConfig()
+		if q, err = querier.NewQuerier(encCfg.InterfaceRegistry, strings.Split(rpcAddress, ","), "/websocket"); err != nil {
+			log.Fatalln(err)
+		}
+	}
+
+	// The checks run before anything is rewound, so that an entity which can not be rebuilt leaves
+	// the database and the sync statuses as they are.
+	checks := []struct {
+		Name string
+		Func func(context.Context, *mongo.Database, int64) error
+	}{
+		{"Delegations", checkDelegations},
+		{"Deposits", checkDeposits},
+	}
+
+	for _, check := range checks {
+		log.Println("Check", check.Name, "ToHeight", toHeight)
+		if err := check.Func(context.TODO(), db, toHeight); err != nil {
+			log.Fatalln(err)
+		}
+	}
+
+	// The steps are not run within a transaction, since a rollback may touch more documents than a
+	// transaction can hold. Every step only depends on the data at or below the height and on the
+	// events above it, which are removed last, so an interrupted rollback can simply be run again.
+	steps := []struct {
+		Name string
+		Func func(context.Context, *mongo.Database, int64) error
+	}{
+		{"SyncStatuses", rollbackSyncStatuses},
+		{"Nodes", rollbackNodes},
+		{"Providers", rollbackProviders},
+		{"Plans", rollbackPlans},
+		{"Deposits", rollbackDeposits},
+		{"Sessions", rollbackSessions},
+		{"Subscriptions", rollbackSubscriptions},
+		{"SubscriptionAllocations", rollbackSubscriptionAllocations},
+		{"Leases", rollbackLeases},
+		{"Accounts", rollbackAccounts},
+		{"Unbondings", rollbackUnbondings},
+		{"Redelegations", rollbackRedelegations},
+		{"Delegations", func(ctx context.Context, db *mongo.Database, height int64) error {
+			return rollbackDelegations(ctx, db, q, height)
+		}},
+		{"Proposals", rollbackProposals},
+		{"ProposalVotes", rollbackProposalVotes},
+		{"IBCTransfers", rollbackIBCTransfers},
+		{"Validators", rollbackValidators},
+		{"AboveHeight", deleteAboveHeight},
+	}
+
+	for _, step := range steps {
+		log.Println("Step", step.Name, "ToHeight", toHeight)
+		if err := step.Func(context.TODO(), db, toHeight); err != nil {
+			log.Fatalln(err)
+		}
+	}
+}
@@ -0,0 +1,833 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+	nodetypes "github.com/sentinel-official/explorer/types/node"
+	providertypes "github.com/sentinel-official/explorer/types/provider"
+)
+
+func rollbackNodes(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"register_height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	if err := database.NodeDeleteMany(ctx, db, filter); err != nil {
+		return err
+	}
+
+	eventTypes := []string{
+		types.EventTypeNodeUpdateDetails,
+		types.EventTypeNodeUpdateStatus,
+	}
+
+	addrs, err := affectedValues(ctx, db, "node_addr", eventTypes, height)
+	if err != nil {
+		return err
+	}
+
+	log.Println("NodesLen", len(addrs))
+	for _, v := range addrs {
+		addr, _ := v.(string)
+
+		item, err := database.NodeFindOne(ctx, db, bson.M{"addr": addr})
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			continue
+		}
+
+		var (
+			gigabytePrices  = item.GigabytePrices
+			hourlyPrices    = item.HourlyPrices
+			remoteURL       = item.RemoteURL
+			status          = hubtypes.StatusInactive.String()
+			statusHeight    = item.RegisterHeight
+			statusTimestamp = item.RegisterTimestamp
+			statusTxHash    = item.RegisterTxHash
+		)
+
+		msgs, err := findTxMessages(ctx, db, item.RegisterTxHash)
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, msg := range msgs {
+			if msg.Type != "/sentinel.node.v2.MsgRegisterRequest" && msg.Type != "/sentinel.node.v2.MsgService/MsgRegister" {
+				continue
+			}
+
+			msgRegister, err := nodetypes.NewMsgRegisterRequest(msg.Data)
+			if err != nil {
+				return err
+			}
+			if msgRegister.NodeAddr().String() != addr {
+				continue
+			}
+
+			found = true
+			gigabytePrices, hourlyPrices, remoteURL = msgRegister.GigabytePrices, msgRegister.HourlyPrices, msgRegister.RemoteURL
+			break
+		}
+
+		if !found {
+			log.Println("RegisterMessageNotFound", addr, "keeping the current details as the base")
+		}
+
+		events, err := findEvents(ctx, db, bson.M{"type": bson.M{"$in": eventTypes}, "node_addr": addr}, height)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			switch event.Type {
+			case types.EventTypeNodeUpdateDetails:
+				if len(event.GigabytePrices) > 0 {
+					gigabytePrices = event.GigabytePrices
+				}
+				if len(event.HourlyPrices) > 0 {
+					hourlyPrices = event.HourlyPrices
+				}
+				if event.RemoteURL != "" {
+					remoteURL = event.RemoteURL
+				}
+			case types.EventTypeNodeUpdateStatus:
+				status, statusHeight, statusTimestamp, statusTxHash = event.Status, event.Height, event.Timestamp, event.TxHash
+			}
+		}
+
+		update := bson.M{
+			"$set": bson.M{
+				"gigabyte_prices":  gigabytePrices,
+				"hourly_prices":    hourlyPrices,
+				"remote_url":       remoteURL,
+				"status":           status,
+				"status_height":    statusHeight,
+				"status_timestamp": statusTimestamp,
+				"status_tx_hash":   statusTxHash,
+			},
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+
+		if _, err := database.NodeFindOneAndUpdate(ctx, db, bson.M{"addr": addr}, update, options.FindOneAndUpdate().SetProjection(projection)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func rollbackProviders(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"register_height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	if err := database.ProviderDeleteMany(ctx, db, filter); err != nil {
+		return err
+	}
+
+	eventTypes := []string{
+		types.EventTypeProviderUpdateDetails,
+	}
+
+	addrs, err := affectedValues(ctx, db, "prov_addr", eventTypes, height)
+	if err != nil {
+		return err
+	}
+
+	log.Println("ProvidersLen", len(addrs))
+	for _, v := range addrs {
+		addr, _ := v.(string)
+
+		item, err := database.ProviderFindOne(ctx, db, bson.M{"addr": addr})
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			continue
+		}
+
+		var (
+			name        = item.Name
+			identity    = item.Identity
+			website     = item.Website
+			description = item.Description
+			status      = hubtypes.StatusInactive.String()
+		)
+
+		msgs, err := findTxMessages(ctx, db, item.RegisterTxHash)
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, msg := range msgs {
+			if msg.Type != "/sentinel.provider.v2.MsgRegisterRequest" && msg.Type != "/sentinel.provider.v2.MsgService/MsgRegister" {
+				continue
+			}
+
+			msgRegister, err := providertypes.NewMsgRegisterRequest(msg.Data)
+			if err != nil {
+				return err
+			}
+			if msgRegister.ProvAddr().String() != addr {
+				continue
+			}
+
+			found = true
+			name, identity, website, description = msgRegister.Name, msgRegister.Identity, msgRegister.Website, msgRegister.Description
+			break
+		}
+
+		if !found {
+			log.Println("RegisterMessageNotFound", addr, "keeping the current details as the base")
+		}
+
+		events, err := findEvents(ctx, db, bson.M{"type": bson.M{"$in": eventTypes}, "prov_addr": addr}, height)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if event.Name != "" {
+				name = event.Name
+			}
+			if event.Identity != "" {
+				identity = event.Identity
+			}
+			if event.Website != "" {
+				website = event.Website
+			}
+			if event.Description != "" {
+				description = event.Description
+			}
+			if event.Status != "" {
+				status = event.Status
+			}
+		}
+
+		update := bson.M{
+			"$set": bson.M{
+				"name":        name,
+				"identity":    identity,
+				"website":     website,
+				"description": description,
+				"status":      status,
+			},
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+
+		if _, err := database.ProviderFindOneAndUpdate(ctx, db, bson.M{"addr": addr}, update, options.FindOneAndUpdate().SetProjection(projection)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func rollbackPlans(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"create_height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	if err := database.PlanDeleteMany(ctx, db, filter); err != nil {
+		return err
+	}
+
+	eventTypes := []string{
+		types.EventTypePlanLinkNode,
+		types.EventTypePlanUnlinkNode,
+		types.EventTypePlanUpdateStatus,
+	}
+
+	ids, err := affectedValues(ctx, db, "plan_id", eventTypes, height)
+	if err != nil {
+		return err
+	}
+
+	log.Println("PlansLen", len(ids))
+	for _, v := range ids {
+		id := uint64FromValue(v)
+
+		item, err := database.PlanFindOne(ctx, db, bson.M{"id": id})
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			continue
+		}
+
+		var (
+			nodeAddrs       = []string{}
+			status          = hubtypes.StatusInactive.String()
+			statusHeight    = item.CreateHeight
+			statusTimestamp = item.CreateTimestamp
+			statusTxHash    = item.CreateTxHash
+		)
+
+		events, err := findEvents(ctx, db, bson.M{"type": bson.M{"$in": eventTypes}, "plan_id": id}, height)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			switch event.Type {
+			case types.EventTypePlanLinkNode:
+				linked := false
+				for _, addr := range nodeAddrs {
+					if addr == event.NodeAddr {
+						linked = true
+						break
+					}
+				}
+
+				if !linked {
+					nodeAddrs = append(nodeAddrs, event.NodeAddr)
+				}
+			case types.EventTypePlanUnlinkNode:
+				for i, addr := range nodeAddrs {
+					if addr == event.NodeAddr {
+						nodeAddrs = append(nodeAddrs[:i], nodeAddrs[i+1:]...)
+						break
+					}
+				}
+			case types.EventTypePlanUpdateStatus:
+				status, statusHeight, statusTimestamp, statusTxHash = event.Status, event.Height, event.Timestamp, event.TxHash
+			}
+		}
+
+		update := bson.M{
+			"$set": bson.M{
+				"node_addrs":       nodeAddrs,
+				"status":           status,
+				"status_height":    statusHeight,
+				"status_timestamp": statusTimestamp,
+				"status_tx_hash":   statusTxHash,
+			},
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+
+		if _, err := database.PlanFindOneAndUpdate(ctx, db, bson.M{"id": id}, update, options.FindOneAndUpdate().SetProjection(projection)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var depositEventTypes = []string{
+	types.EventTypeDepositAdd,
+	types.EventTypeDepositSnapshot,
+	types.EventTypeDepositSubtract,
+}
+
+// depositCoins returns the coins of the deposit after the events, in the order in which they were
+// indexed. A snapshot, written by 11_bootstrap, holds the coins of the deposit at its height.
+func depositCoins(events []*models.Event) types.Coins {
+	coins := types.NewCoins(nil)
+	for _, event := range events {
+		switch event.Type {
+		case types.EventTypeDepositAdd:
+			coins = coins.Add(event.Coins...)
+		case types.EventTypeDepositSnapshot:
+			coins = event.Coins.Copy()
+		case types.EventTypeDepositSubtract:
+			coins = coins.Sub(event.Coins...)
+		}
+	}
+
+	return coins
+}
+
+// coinsEqual reports whether the coins hold the same non-zero amounts.
+func coinsEqual(a, b types.Coins) bool {
+	amounts := make(map[string]string)
+	for _, c := range a {
+		if c.Amount != "0" {
+			amounts[c.Denom] = c.Amount
+		}
+	}
+
+	n := 0
+	for _, c := range b {
+		if c.Amount == "0" {
+			continue
+		}
+		if amounts[c.Denom] != c.Amount {
+			return false
+		}
+
+		n++
+	}
+
+	return n == len(amounts)
+}
+
+// checkDeposits fails when the events of a deposit changed above the height do not add up to its
+// coins, as for the deposits which were bootstrapped or indexed from a later height without their
+// events. Such a deposit can not be rebuilt as of the height.
+func checkDeposits(ctx context.Context, db *mongo.Database, height int64) error {
+	addrs, err := affectedValues(ctx, db, "acc_addr", depositEventTypes, height)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range addrs {
+		addr, _ := v.(string)
+
+		dDeposit, err := database.DepositFindOne(ctx, db, bson.M{"addr": addr})
+		if err != nil {
+			return err
+		}
+
+		// A deposit which is at or below the height was rebuilt by an interrupted rollback.
+		if dDeposit == nil || dDeposit.Height <= height {
+			continue
+		}
+
+		events, err := findEvents(ctx, db, bson.M{"type": bson.M{"$in": depositEventTypes}, "acc_addr": addr}, math.MaxInt64)
+		if err != nil {
+			return err
+		}
+
+		if !coinsEqual(depositCoins(events), dDeposit.Coins) {
+			return fmt.Errorf("events of the deposit %s do not add up to its coins, it can not be rebuilt", addr)
+		}
+	}
+
+	return nil
+}
+
+func rollbackDeposits(ctx context.Context, db *mongo.Database, height int64) error {
+	addrs, err := affectedValues(ctx, db, "acc_addr", depositEventTypes, height)
+	if err != nil {
+		return err
+	}
+
+	log.Println("DepositsLen", len(addrs))
+	for _, v := range addrs {
+		addr, _ := v.(string)
+
+		events, err := findEvents(ctx, db, bson.M{"type": bson.M{"$in": depositEventTypes}, "acc_addr": addr}, height)
+		if err != nil {
+			return err
+		}
+
+		if len(events) == 0 {
+			if err := database.DepositDeleteMany(ctx, db, bson.M{"addr": addr}); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		coins := depositCoins(events)
+		last := events[len(events)-1]
+		update := bson.M{
+			"$set": bson.M{
+				"coins":     coins,
+				"height":    last.Height,
+				"timestamp": last.Timestamp,
+				"tx_hash":   last.TxHash,
+			},
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+
+		if _, err := database.DepositFindOneAndUpdate(ctx, db, bson.M{"addr": addr}, update, options.FindOneAndUpdate().SetProjection(projection)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// affectedIDs merges the ids referenced by the events above the height with the ids of the items
+// whose status changed above the height. The latter also covers the details which are updated
+// without an event, like the payment of a session or the refund of a subscription.
+func affectedIDs(
+	ctx context.Context, db *mongo.Database, fieldName string, eventTypes []string, height int64,
+	distinctFunc func(context.Context, *mongo.Database, string, bson.M, ...*options.DistinctOptions) (bson.A, error),
+) ([]uint64, error) {
+	values, err := affectedValues(ctx, db, fieldName, eventTypes, height)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{
+		"status_height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	items, err := distinctFunc(ctx, db, "id", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		ids  []uint64
+		seen = make(map[uint64]bool)
+	)
+
+	for _, v := range append(values, items...) {
+		id := uint64FromValue(v)
+		if seen[id] {
+			continue
+		}
+
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func rollbackSessions(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"start_height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	if err := database.SessionDeleteMany(ctx, db, filter); err != nil {
+		return err
+	}
+
+	eventTypes := []string{
+		types.EventTypeSessionUpdateDetails,
+		types.EventTypeSessionUpdateStatus,
+	}
+
+	ids, err := affectedIDs(ctx, db, "session_id", eventTypes, height, database.SessionDistinct)
+	if err != nil {
+		return err
+	}
+
+	log.Println("SessionsLen", len(ids))
+	for _, id := range ids {
+		item, err := database.SessionFindOne(ctx, db, bson.M{"id": id})
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			continue
+		}
+
+		var (
+			bandwidth       *types.Bandwidth
+			duration        int64
+			status          = hubtypes.StatusActive.String()
+			statusHeight    = item.StartHeight
+			statusTimestamp = item.StartTimestamp
+			statusTxHash    = item.StartTxHash
+		)
+
+		events, err := findEvents(ctx, db, bson.M{"type": bson.M{"$in": eventTypes}, "session_id": id}, height)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			switch event.Type {
+			case types.EventTypeSessionUpdateDetails:
+				if event.Bandwidth != nil {
+					bandwidth = event.Bandwidth
+				}
+
+				duration = event.Duration
+			case types.EventTypeSessionUpdateStatus:
+				status, statusHeight, statusTimestamp, statusTxHash = event.Status, event.Height, event.Timestamp, event.TxHash
+			}
+		}
+
+		updateSet := bson.M{
+			"bandwidth":        bandwidth,
+			"duration":         duration,
+			"status":           status,
+			"status_height":    statusHeight,
+			"status_timestamp": statusTimestamp,
+			"status_tx_hash":   statusTxHash,
+		}
+		if status != hubtypes.StatusInactive.String() {
+			updateSet["end_height"] = 0
+			updateSet["end_timestamp"] = time.Time{}
+			updateSet["end_tx_hash"] = ""
+			updateSet["payment"] = nil
+			updateSet["staking_reward"] = nil
+		}
+		if status == hubtypes.StatusActive.String() {
+			updateSet["rating"] = 0
+		}
+
+		update := bson.M{
+			"$set": updateSet,
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+
+		if _, err := database.SessionFindOneAndUpdate(ctx, db, bson.M{"id": id}, update, options.FindOneAndUpdate().SetProjection(projection)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func rollbackSubscriptions(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"start_height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	if err := database.SubscriptionDeleteMany(ctx, db, filter); err != nil {
+		return err
+	}
+
+	eventTypes := []string{
+		types.EventTypeSubscriptionUpdateDetails,
+		types.EventTypeSubscriptionUpdateStatus,
+	}
+
+	ids, err := affectedIDs(ctx, db, "subscription_id", eventTypes, height, database.SubscriptionDistinct)
+	if err != nil {
+		return err
+	}
+
+	log.Println("SubscriptionsLen", len(ids))
+	for _, id := range ids {
+		item, err := database.SubscriptionFindOne(ctx, db, bson.M{"id": id})
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			continue
+		}
+
+		var (
+			status          = hubtypes.StatusActive.String()
+			statusHeight    = item.StartHeight
+			statusTimestamp = item.StartTimestamp
+			statusTxHash    = item.StartTxHash
+		)
+
+		events, err := findEvents(ctx, db, bson.M{"type": types.EventTypeSubscriptionUpdateStatus, "subscription_id": id}, height)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			status, statusHeight, statusTimestamp, statusTxHash = event.Status, event.Height, event.Timestamp, event.TxHash
+		}
+
+		updateSet := bson.M{
+			"status":           status,
+			"status_height":    statusHeight,
+			"status_timestamp": statusTimestamp,
+			"status_tx_hash":   statusTxHash,
+		}
+		if status != hubtypes.StatusInactive.String() {
+			updateSet["end_height"] = 0
+			updateSet["end_timestamp"] = time.Time{}
+			updateSet["end_tx_hash"] = ""
+			updateSet["refund"] = nil
+		}
+
+		update := bson.M{
+			"$set": updateSet,
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+
+		if _, err := database.SubscriptionFindOneAndUpdate(ctx, db, bson.M{"id": id}, update, options.FindOneAndUpdate().SetProjection(projection)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rollbackLeases restores the status and the renewal details of the leases from
+// their events. The hours, the payout time and the payments are not recorded as
+// events, those are left as they are until the next update of the lease.
+func rollbackLeases(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"start_height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	if err := database.LeaseDeleteMany(ctx, db, filter); err != nil {
+		return err
+	}
+
+	eventTypes := []string{
+		types.EventTypeLeaseUpdateDetails,
+		types.EventTypeLeaseUpdateStatus,
+	}
+
+	ids, err := affectedIDs(ctx, db, "lease_id", eventTypes, height, database.LeaseDistinct)
+	if err != nil {
+		return err
+	}
+
+	log.Println("LeasesLen", len(ids))
+	for _, id := range ids {
+		item, err := database.LeaseFindOne(ctx, db, bson.M{"id": id})
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			continue
+		}
+
+		var (
+			maxHours        = item.MaxHours
+			price           = item.Price
+			status          = hubtypes.StatusActive.String()
+			statusHeight    = item.StartHeight
+			statusTimestamp = item.StartTimestamp
+			statusTxHash    = item.StartTxHash
+		)
+
+		events, err := findEvents(ctx, db, bson.M{"type": bson.M{"$in": eventTypes}, "lease_id": id}, height)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			switch event.Type {
+			case types.EventTypeLeaseUpdateDetails:
+				maxHours = event.Duration
+				if len(event.Coins) > 0 {
+					price = event.Coins[0]
+				}
+			case types.EventTypeLeaseUpdateStatus:
+				status, statusHeight, statusTimestamp, statusTxHash = event.Status, event.Height, event.Timestamp, event.TxHash
+			}
+		}
+
+		updateSet := bson.M{
+			"max_hours":        maxHours,
+			"price":            price,
+			"status":           status,
+			"status_height":    statusHeight,
+			"status_timestamp": statusTimestamp,
+			"status_tx_hash":   statusTxHash,
+		}
+		if status != hubtypes.StatusInactive.String() {
+			updateSet["end_height"] = 0
+			updateSet["end_timestamp"] = time.Time{}
+			updateSet["end_tx_hash"] = ""
+			updateSet["refund"] = nil
+		}
+
+		update := bson.M{
+			"$set": updateSet,
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+
+		if _, err := database.LeaseFindOneAndUpdate(ctx, db, bson.M{"id": id}, update, options.FindOneAndUpdate().SetProjection(projection)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func rollbackSubscriptionAllocations(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"type": types.EventTypeSubscriptionAllocationUpdateDetails,
+		"height": bson.M{
+			"$gt": height,
+		},
+	}
+	projection := bson.M{
+		"_id":             0,
+		"subscription_id": 1,
+		"acc_addr":        1,
+	}
+
+	items, err := database.EventFind(ctx, db, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, item := range items {
+		key := fmt.Sprintf("%d/%s", item.SubscriptionID, item.AccAddr)
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		filter := bson.M{
+			"id":       item.SubscriptionID,
+			"acc_addr": item.AccAddr,
+		}
+
+		events, err := findEvents(ctx, db, bson.M{"type": types.EventTypeSubscriptionAllocationUpdateDetails, "subscription_id": item.SubscriptionID, "acc_addr": item.AccAddr}, height)
+		if err != nil {
+			return err
+		}
+
+		if len(events) == 0 {
+			if err := database.SubscriptionAllocationDeleteMany(ctx, db, filter); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		last := events[len(events)-1]
+		update := bson.M{
+			"$set": bson.M{
+				"granted_bytes":  last.GrantedBytes,
+				"utilised_bytes": last.UtilisedBytes,
+			},
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+
+		if _, err := database.SubscriptionAllocationFindOneAndUpdate(ctx, db, filter, update, options.FindOneAndUpdate().SetProjection(projection)); err != nil {
+			return err
+		}
+	}
+
+	log.Println("SubscriptionAllocationsLen", len(seen))
+	return nil
+}
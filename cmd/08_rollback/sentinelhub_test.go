@@ -0,0 +1,59 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+func TestDepositCoins(t *testing.T) {
+	events := []*models.Event{
+		{Type: types.EventTypeDepositAdd, Coins: types.Coins{{Denom: "udvpn", Amount: "100"}}},
+		{Type: types.EventTypeDepositAdd, Coins: types.Coins{{Denom: "uatom", Amount: "5"}}},
+		{Type: types.EventTypeDepositSubtract, Coins: types.Coins{{Denom: "udvpn", Amount: "40"}}},
+		{Type: types.EventTypeDepositSubtract, Coins: types.Coins{{Denom: "uatom", Amount: "5"}}},
+	}
+
+	coins := depositCoins(events)
+	if !coinsEqual(coins, types.Coins{{Denom: "udvpn", Amount: "60"}}) {
+		t.Fatalf("got coins %v", coins)
+	}
+}
+
+func TestDepositCoinsSnapshot(t *testing.T) {
+	events := []*models.Event{
+		{Type: types.EventTypeDepositAdd, Coins: types.Coins{{Denom: "udvpn", Amount: "100"}}},
+		{Type: types.EventTypeDepositSnapshot, Coins: types.Coins{{Denom: "udvpn", Amount: "30"}}},
+		{Type: types.EventTypeDepositAdd, Coins: types.Coins{{Denom: "udvpn", Amount: "10"}}},
+	}
+
+	coins := depositCoins(events)
+	if !coinsEqual(coins, types.Coins{{Denom: "udvpn", Amount: "40"}}) {
+		t.Fatalf("got coins %v", coins)
+	}
+	if events[1].Coins[0].Amount != "30" {
+		t.Fatal("expected the coins of the snapshot not to be modified")
+	}
+}
+
+func TestCoinsEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b types.Coins
+		want bool
+	}{
+		{"empty", nil, types.Coins{}, true},
+		{"zero amounts", types.Coins{{Denom: "udvpn", Amount: "0"}}, nil, true},
+		{"same", types.Coins{{Denom: "udvpn", Amount: "1"}}, types.Coins{{Denom: "udvpn", Amount: "1"}}, true},
+		{"amount", types.Coins{{Denom: "udvpn", Amount: "1"}}, types.Coins{{Denom: "udvpn", Amount: "2"}}, false},
+		{"missing", types.Coins{{Denom: "udvpn", Amount: "1"}}, nil, false},
+		{"extra", nil, types.Coins{{Denom: "udvpn", Amount: "1"}}, false},
+	}
+
+	for _, tt := range tests {
+		if got := coinsEqual(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: got %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+)
+
+var flagAbsent = fmt.Sprintf("%v", tmtypes.BlockIDFlagAbsent)
+
+// signedBlocks returns the number of blocks signed by each validator within the heights from and
+// to, the former excluded.
+func signedBlocks(ctx context.Context, db *mongo.Database, from, to int64) (map[string]int64, error) {
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"height": bson.M{
+					"$gt":  from,
+					"$lte": to,
+				},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id":        0,
+				"signatures": 1,
+			},
+		},
+		{
+			"$unwind": "$signatures",
+		},
+		{
+			"$match": bson.M{
+				"signatures.flag": bson.M{
+					"$ne": flagAbsent,
+				},
+			},
+		},
+		{
+			"$group": bson.M{
+				"_id": "$signatures.validator_address",
+				"count": bson.M{
+					"$sum": 1,
+				},
+			},
+		},
+	}
+
+	result, err := database.BlockAggregateAll(ctx, db, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]int64)
+	for _, item := range result {
+		consAddr, _ := item["_id"].(string)
+		items[consAddr] = int64(uint64FromValue(item["count"]))
+	}
+
+	return items, nil
+}
+
+// rollbackValidators removes the validators which entered the set above the height, and rebuilds
+// the counters of the ones in the set above it. The missed and proposed blocks and the uptime
+// windows are counted from the validator blocks at or below the height, while the signed blocks,
+// which are not kept, are reduced by the signatures of the blocks above it. A validator is moved
+// back to the height along with its counters, so an interrupted rollback does not reduce them twice.
+func rollbackValidators(ctx context.Context, db *mongo.Database, height int64) error {
+	filter := bson.M{
+		"start_height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	if err := database.ValidatorDeleteMany(ctx, db, filter); err != nil {
+		return err
+	}
+
+	filter = bson.M{
+		"last_height": bson.M{
+			"$gt": height,
+		},
+	}
+	projection := bson.M{
+		"_id":            0,
+		"cons_addr":      1,
+		"last_height":    1,
+		"uptime_windows": 1,
+	}
+
+	dValidators, err := database.ValidatorFind(ctx, db, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		return err
+	}
+
+	log.Println("ValidatorsLen", len(dValidators))
+	if len(dValidators) > 0 {
+		lastHeight := height
+		for _, dValidator := range dValidators {
+			lastHeight = max(lastHeight, dValidator.LastHeight)
+		}
+
+		signed, err := signedBlocks(ctx, db, height, lastHeight)
+		if err != nil {
+			return err
+		}
+
+		for _, dValidator := range dValidators {
+			count := func(filter bson.M) (int64, error) {
+				filter["cons_addr"] = dValidator.ConsAddr
+				return database.ValidatorBlockCountDocuments(ctx, db, filter)
+			}
+
+			missed, err := count(bson.M{"missed": true, "height": bson.M{"$lte": height}})
+			if err != nil {
+				return err
+			}
+
+			proposed, err := count(bson.M{"proposed": true, "height": bson.M{"$lte": height}})
+			if err != nil {
+				return err
+			}
+
+			updateSet := bson.M{
+				"missed_blocks":   missed,
+				"proposed_blocks": proposed,
+				"last_height":     height,
+			}
+
+			for key := range dValidator.UptimeWindows {
+				w, err := strconv.ParseInt(key, 10, 64)
+				if err != nil {
+					return err
+				}
+
+				n, err := count(bson.M{"missed": true, "height": bson.M{"$gt": height - w, "$lte": height}})
+				if err != nil {
+					return err
+				}
+
+				updateSet["uptime_windows."+key] = n
+			}
+
+			filter := bson.M{
+				"cons_addr": dValidator.ConsAddr,
+				"last_height": bson.M{
+					"$gt": height,
+				},
+			}
+			update := bson.M{
+				"$set": updateSet,
+				"$inc": bson.M{
+					"signed_blocks": -signed[dValidator.ConsAddr],
+				},
+			}
+			projection := bson.M{
+				"_id": 1,
+			}
+
+			if _, err := database.ValidatorFindOneAndUpdate(ctx, db, filter, update, options.FindOneAndUpdate().SetProjection(projection)); err != nil {
+				return err
+			}
+		}
+	}
+
+	filter = bson.M{
+		"height": bson.M{
+			"$gt": height,
+		},
+	}
+
+	return database.ValidatorBlockDeleteMany(ctx, db, filter)
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/migrations"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const appName = "09_tx-index"
+
+var (
+	dbAddress  string
+	dbName     string
+	dbUsername string
+	dbPassword string
+	batchSize  int64
+)
+
+func init() {
+	log.SetFlags(0)
+
+	flag.StringVar(&dbAddress, "db-address", "mongodb://127.0.0.1:27017", "")
+	flag.StringVar(&dbName, "db-name", "sentinelhub-2", "")
+	flag.StringVar(&dbUsername, "db-username", "", "")
+	flag.StringVar(&dbPassword, "db-password", "", "")
+	flag.Int64Var(&batchSize, "batch-size", 1000, "")
+	flag.Parse()
+}
+
+// txDocument is a transaction along with the id of its document.
+type txDocument struct {
+	ID       primitive.ObjectID `bson:"_id"`
+	Messages models.Messages    `bson:"messages"`
+}
+
+func createIndexes(ctx context.Context, db *mongo.Database) error {
+	return migrations.CreateIndexes(ctx, db, database.TxCollectionName)
+}
+
+// main fills the msg_types and signers of the transactions indexed before those fields existed.
+func main() {
+	if batchSize < 1 {
+		log.Fatalln("batch-size must be greater than zero")
+	}
+
+	db, err := utils.PrepareDatabase(context.TODO(), appName, dbUsername, dbPassword, dbAddress, dbName)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if err = db.Client().Ping(context.TODO(), nil); err != nil {
+		log.Fatalln(err)
+	}
+
+	if err := createIndexes(context.TODO(), db); err != nil {
+		log.Fatalln(err)
+	}
+
+	// The transactions are walked in the order of their ids, so that each
+	// batch resumes from the last one instead of scanning the collection
+	// again, and are updated by their ids.
+	var lastID primitive.ObjectID
+
+	projection := bson.M{
+		"_id":      1,
+		"messages": 1,
+	}
+	opts := options.Find().
+		SetProjection(projection).
+		SetSort(bson.D{bson.E{Key: "_id", Value: 1}}).
+		SetLimit(batchSize)
+
+	for {
+		filter := bson.M{
+			"_id": bson.M{
+				"$gt": lastID,
+			},
+			"msg_types": bson.M{
+				"$exists": false,
+			},
+		}
+
+		var dTxs []*txDocument
+		if err := database.Find(context.TODO(), db.Collection(database.TxCollectionName), filter, &dTxs, opts); err != nil {
+			log.Fatalln(err)
+		}
+
+		log.Println("TxsLen", len(dTxs))
+		if len(dTxs) == 0 {
+			break
+		}
+
+		writeModels := make([]mongo.WriteModel, 0, len(dTxs))
+		for i := 0; i < len(dTxs); i++ {
+			filter := bson.M{
+				"_id": dTxs[i].ID,
+			}
+			update := bson.M{
+				"$set": bson.M{
+					"msg_types": dTxs[i].Messages.Types(),
+					"signers":   dTxs[i].Messages.Signers(),
+				},
+			}
+
+			writeModels = append(writeModels, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update))
+		}
+
+		if _, err := database.TxBulkWrite(context.TODO(), db, writeModels); err != nil {
+			log.Fatalln(err)
+		}
+
+		lastID = dTxs[len(dTxs)-1].ID
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/querier"
+	"github.com/sentinel-official/explorer/types"
+)
+
+// reconcileDeposits treats a deposit which is not held by the chain as an
+// empty one, since the chain removes the deposits once they are spent.
+func reconcileDeposits(ctx context.Context, db *mongo.Database, q *querier.Querier, height int64) (drifts []*Drift, err error) {
+	items, err := q.QueryDeposits(ctx, pageLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	dDeposits, err := database.DepositFind(ctx, db, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]*models.Deposit)
+	for i := 0; i < len(dDeposits); i++ {
+		m[dDeposits[i].Addr] = dDeposits[i]
+	}
+
+	for _, item := range items {
+		coins := types.NewCoins(item.Coins)
+
+		dDeposit, ok := m[item.Address]
+		if !ok {
+			drifts = append(drifts, newMissingDrift(database.DepositCollectionName, item.Address, &models.Deposit{
+				Addr:   item.Address,
+				Coins:  coins,
+				Height: height,
+			}))
+			continue
+		}
+
+		delete(m, item.Address)
+
+		d := newDiffer()
+		d.compare("coins", coinsString(dDeposit.Coins), coinsString(coins), coins)
+
+		if drift := d.drift(database.DepositCollectionName, item.Address, bson.M{"addr": item.Address}); drift != nil {
+			drifts = append(drifts, drift)
+		}
+	}
+
+	for addr, dDeposit := range m {
+		if coinsString(dDeposit.Coins) == "" {
+			continue
+		}
+
+		drifts = append(drifts, newStaleDrift(database.DepositCollectionName, addr, bson.M{"addr": addr}, bson.M{"coins": types.NewCoins(nil)}))
+	}
+
+	return drifts, nil
+}
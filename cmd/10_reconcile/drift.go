@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+const (
+	DriftKindMissing  = "missing"
+	DriftKindMismatch = "mismatch"
+	DriftKindStale    = "stale"
+)
+
+// Drift is a document which does not match the on-chain state. Model holds the
+// write which brings the document back in line with the chain.
+type Drift struct {
+	Collection string
+	Key        string
+	Kind       string
+	Diffs      []string
+	Model      mongo.WriteModel
+}
+
+func (d *Drift) String() string {
+	return fmt.Sprintf("%s %s %s %s", d.Collection, d.Kind, d.Key, strings.Join(d.Diffs, " "))
+}
+
+// differ collects the fields whose database value differs from the chain value,
+// along with the chain values to be set on repair.
+type differ struct {
+	diffs []string
+	set   bson.M
+}
+
+func newDiffer() *differ {
+	return &differ{
+		set: bson.M{},
+	}
+}
+
+func (d *differ) compare(field string, dbValue, chainValue string, v interface{}) {
+	if dbValue == chainValue {
+		return
+	}
+
+	d.diffs = append(d.diffs, fmt.Sprintf("%s=%q->%q", field, dbValue, chainValue))
+	d.set[field] = v
+}
+
+func (d *differ) drift(collection, key string, filter bson.M) *Drift {
+	if len(d.diffs) == 0 {
+		return nil
+	}
+
+	return &Drift{
+		Collection: collection,
+		Key:        key,
+		Kind:       DriftKindMismatch,
+		Diffs:      d.diffs,
+		Model: mongo.NewUpdateOneModel().
+			SetFilter(filter).
+			SetUpdate(bson.M{"$set": d.set}),
+	}
+}
+
+func newMissingDrift(collection, key string, v interface{}) *Drift {
+	return &Drift{
+		Collection: collection,
+		Key:        key,
+		Kind:       DriftKindMissing,
+		Model:      mongo.NewInsertOneModel().SetDocument(v),
+	}
+}
+
+func newStaleDrift(collection, key string, filter, set bson.M) *Drift {
+	return &Drift{
+		Collection: collection,
+		Key:        key,
+		Kind:       DriftKindStale,
+		Model: mongo.NewUpdateOneModel().
+			SetFilter(filter).
+			SetUpdate(bson.M{"$set": set}),
+	}
+}
+
+// normalizeStatus maps both the explorer and the proto enum spellings of a status
+// to the form stored by the explorer.
+func normalizeStatus(s string) string {
+	s = strings.TrimPrefix(strings.ToLower(s), "status_")
+	return hubtypes.StatusFromString(s).String()
+}
+
+// coinsString returns the sorted non-zero coins, so that the documents holding
+// zero amounts compare equal to the chain state.
+func coinsString(v types.Coins) string {
+	items := make([]string, 0, len(v))
+	for _, c := range v {
+		if c == nil || c.Amount == "" || c.Amount == "0" {
+			continue
+		}
+
+		items = append(items, c.Amount+c.Denom)
+	}
+
+	sort.Strings(items)
+	return strings.Join(items, ",")
+}
+
+func coinString(v *types.Coin) string {
+	if v == nil {
+		return ""
+	}
+
+	return coinsString(types.Coins{v})
+}
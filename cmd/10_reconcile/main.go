@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"strings"
+
+	"github.com/sentinel-official/hub/app"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/querier"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	appName = "10_reconcile"
+
+	// syncAppName is the indexer whose height is reconciled when no height is given.
+	syncAppName = "03_sentinelhub"
+)
+
+var (
+	height     int64
+	repair     bool
+	pageLimit  uint64
+	rpcAddress string
+	dbAddress  string
+	dbName     string
+	dbUsername string
+	dbPassword string
+)
+
+func init() {
+	log.SetFlags(0)
+
+	flag.Int64Var(&height, "height", 0, "")
+	flag.BoolVar(&repair, "repair", false, "")
+	flag.Uint64Var(&pageLimit, "page-limit", 1000, "")
+	flag.StringVar(&rpcAddress, "rpc-address", "http://127.0.0.1:26657", "")
+	flag.StringVar(&dbAddress, "db-address", "mongodb://127.0.0.1:27017", "")
+	flag.StringVar(&dbName, "db-name", "sentinelhub-2", "")
+	flag.StringVar(&dbUsername, "db-username", "", "")
+	flag.StringVar(&dbPassword, "db-password", "", "")
+	flag.Parse()
+}
+
+type bulkWriteFunc func(context.Context, *mongo.Database, []mongo.WriteModel, ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error)
+
+// repairDrifts writes the models of the drifts of a collection. The writes are
+// unordered, so that a single failing document does not hold back the others.
+func repairDrifts(ctx context.Context, db *mongo.Database, collection string, fn bulkWriteFunc, drifts []*Drift) error {
+	if len(drifts) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(drifts))
+	for _, drift := range drifts {
+		models = append(models, drift.Model)
+	}
+
+	result, err := fn(ctx, db, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return err
+	}
+
+	log.Println("Repaired", collection, "Inserted", result.InsertedCount, "Modified", result.ModifiedCount)
+	return nil
+}
+
+func main() {
+	if pageLimit == 0 {
+		log.Fatalln("page-limit must be greater than zero")
+	}
+
+	encCfg := app.DefaultEncodingConfig()
+
+	q, err := querier.NewQuerier(encCfg.InterfaceRegistry, strings.Split(rpcAddress, ","), "/websocket")
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	db, err := utils.PrepareDatabase(context.TODO(), appName, dbUsername, dbPassword, dbAddress, dbName)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if height == 0 {
+		filter := bson.M{
+			"app_name": syncAppName,
+		}
+
+		dSyncStatus, err := database.SyncStatusFindOne(context.TODO(), db, filter)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		if dSyncStatus == nil {
+			log.Fatalln("sync status of", syncAppName, "does not exist")
+		}
+
+		height = dSyncStatus.Height
+	}
+	if height <= 0 {
+		log.Fatalln("height must be greater than zero")
+	}
+
+	log.Println("Height", height)
+
+	// Every query is made against the state at the height, so that the chain state
+	// is comparable with the documents written up to the same height.
+	ctx := querier.ContextWithHeight(context.TODO(), height)
+
+	steps := []struct {
+		Collection string
+		Reconcile  func(context.Context, *mongo.Database, *querier.Querier, int64) ([]*Drift, error)
+		BulkWrite  bulkWriteFunc
+	}{
+		{database.NodeCollectionName, reconcileNodes, database.NodeBulkWrite},
+		{database.ProviderCollectionName, reconcileProviders, database.ProviderBulkWrite},
+		{database.PlanCollectionName, reconcilePlans, database.PlanBulkWrite},
+		{database.DepositCollectionName, reconcileDeposits, database.DepositBulkWrite},
+		{database.SubscriptionCollectionName, reconcileSubscriptions, database.SubscriptionBulkWrite},
+		{database.SessionCollectionName, reconcileSessions, database.SessionBulkWrite},
+	}
+
+	total := 0
+	for _, step := range steps {
+		drifts, err := step.Reconcile(ctx, db, q, height)
+		if err != nil {
+			log.Fatalln(step.Collection, err)
+		}
+
+		counts := make(map[string]int)
+		for _, drift := range drifts {
+			counts[drift.Kind]++
+			log.Println("Drift", drift)
+		}
+
+		log.Println(
+			"Summary", step.Collection,
+			DriftKindMissing, counts[DriftKindMissing],
+			DriftKindMismatch, counts[DriftKindMismatch],
+			DriftKindStale, counts[DriftKindStale],
+		)
+
+		total += len(drifts)
+		if !repair {
+			continue
+		}
+
+		if err := repairDrifts(context.TODO(), db, step.Collection, step.BulkWrite, drifts); err != nil {
+			log.Fatalln(step.Collection, err)
+		}
+	}
+
+	log.Println("Total", total, "Repair", repair)
+}
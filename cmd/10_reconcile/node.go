@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/querier"
+	"github.com/sentinel-official/explorer/types"
+)
+
+func reconcileNodes(ctx context.Context, db *mongo.Database, q *querier.Querier, height int64) (drifts []*Drift, err error) {
+	items, err := q.QueryNodes(ctx, pageLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	dNodes, err := database.NodeFind(ctx, db, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]*models.Node)
+	for i := 0; i < len(dNodes); i++ {
+		m[dNodes[i].Addr] = dNodes[i]
+	}
+
+	for _, item := range items {
+		var (
+			gigabytePrices = types.NewCoins(item.GigabytePrices)
+			hourlyPrices   = types.NewCoins(item.HourlyPrices)
+			status         = item.Status.String()
+		)
+
+		dNode, ok := m[item.Address]
+		if !ok {
+			drifts = append(drifts, newMissingDrift(database.NodeCollectionName, item.Address, &models.Node{
+				Addr:            item.Address,
+				GigabytePrices:  gigabytePrices,
+				HourlyPrices:    hourlyPrices,
+				RemoteURL:       item.RemoteURL,
+				Status:          status,
+				StatusHeight:    height,
+				StatusTimestamp: item.StatusAt,
+			}))
+			continue
+		}
+
+		delete(m, item.Address)
+
+		d := newDiffer()
+		d.compare("gigabyte_prices", coinsString(dNode.GigabytePrices), coinsString(gigabytePrices), gigabytePrices)
+		d.compare("hourly_prices", coinsString(dNode.HourlyPrices), coinsString(hourlyPrices), hourlyPrices)
+		d.compare("remote_url", dNode.RemoteURL, item.RemoteURL, item.RemoteURL)
+		d.compare("status", normalizeStatus(dNode.Status), status, status)
+
+		if drift := d.drift(database.NodeCollectionName, item.Address, bson.M{"addr": item.Address}); drift != nil {
+			drifts = append(drifts, drift)
+		}
+	}
+
+	for addr, dNode := range m {
+		if normalizeStatus(dNode.Status) == hubtypes.StatusInactive.String() {
+			continue
+		}
+
+		drifts = append(drifts, newStaleDrift(database.NodeCollectionName, addr, bson.M{"addr": addr}, bson.M{"status": hubtypes.StatusInactive.String()}))
+	}
+
+	return drifts, nil
+}
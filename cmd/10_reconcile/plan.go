@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"strconv"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/querier"
+	"github.com/sentinel-official/explorer/types"
+)
+
+func reconcilePlans(ctx context.Context, db *mongo.Database, q *querier.Querier, height int64) (drifts []*Drift, err error) {
+	items, err := q.QueryPlans(ctx, pageLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	dPlans, err := database.PlanFind(ctx, db, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[uint64]*models.Plan)
+	for i := 0; i < len(dPlans); i++ {
+		m[dPlans[i].ID] = dPlans[i]
+	}
+
+	for _, item := range items {
+		var (
+			key    = strconv.FormatUint(item.ID, 10)
+			prices = types.NewCoins(item.Prices)
+			status = item.Status.String()
+		)
+
+		dPlan, ok := m[item.ID]
+		if !ok {
+			drifts = append(drifts, newMissingDrift(database.PlanCollectionName, key, &models.Plan{
+				ID:              item.ID,
+				ProvAddr:        item.ProviderAddress,
+				Duration:        item.Duration.Nanoseconds(),
+				Gigabytes:       item.Gigabytes,
+				Prices:          prices,
+				NodeAddrs:       []string{},
+				Status:          status,
+				StatusHeight:    height,
+				StatusTimestamp: item.StatusAt,
+			}))
+			continue
+		}
+
+		delete(m, item.ID)
+
+		d := newDiffer()
+		d.compare("prov_addr", dPlan.ProvAddr, item.ProviderAddress, item.ProviderAddress)
+		d.compare("duration", strconv.FormatInt(dPlan.Duration, 10), strconv.FormatInt(item.Duration.Nanoseconds(), 10), item.Duration.Nanoseconds())
+		d.compare("gigabytes", strconv.FormatInt(dPlan.Gigabytes, 10), strconv.FormatInt(item.Gigabytes, 10), item.Gigabytes)
+		d.compare("prices", coinsString(dPlan.Prices), coinsString(prices), prices)
+		d.compare("status", normalizeStatus(dPlan.Status), status, status)
+
+		if drift := d.drift(database.PlanCollectionName, key, bson.M{"id": item.ID}); drift != nil {
+			drifts = append(drifts, drift)
+		}
+	}
+
+	for id, dPlan := range m {
+		if normalizeStatus(dPlan.Status) == hubtypes.StatusInactive.String() {
+			continue
+		}
+
+		drifts = append(drifts, newStaleDrift(database.PlanCollectionName, strconv.FormatUint(id, 10), bson.M{"id": id}, bson.M{"status": hubtypes.StatusInactive.String()}))
+	}
+
+	return drifts, nil
+}
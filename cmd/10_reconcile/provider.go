@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/querier"
+)
+
+func reconcileProviders(ctx context.Context, db *mongo.Database, q *querier.Querier, height int64) (drifts []*Drift, err error) {
+	items, err := q.QueryProviders(ctx, pageLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	dProviders, err := database.ProviderFind(ctx, db, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]*models.Provider)
+	for i := 0; i < len(dProviders); i++ {
+		m[dProviders[i].Addr] = dProviders[i]
+	}
+
+	for _, item := range items {
+		status := item.Status.String()
+
+		dProvider, ok := m[item.Address]
+		if !ok {
+			drifts = append(drifts, newMissingDrift(database.ProviderCollectionName, item.Address, &models.Provider{
+				Addr:            item.Address,
+				Name:            item.Name,
+				Identity:        item.Identity,
+				Website:         item.Website,
+				Description:     item.Description,
+				Status:          status,
+				StatusHeight:    height,
+				StatusTimestamp: item.StatusAt,
+			}))
+			continue
+		}
+
+		delete(m, item.Address)
+
+		d := newDiffer()
+		d.compare("name", dProvider.Name, item.Name, item.Name)
+		d.compare("identity", dProvider.Identity, item.Identity, item.Identity)
+		d.compare("website", dProvider.Website, item.Website, item.Website)
+		d.compare("description", dProvider.Description, item.Description, item.Description)
+		d.compare("status", normalizeStatus(dProvider.Status), status, status)
+
+		if drift := d.drift(database.ProviderCollectionName, item.Address, bson.M{"addr": item.Address}); drift != nil {
+			drifts = append(drifts, drift)
+		}
+	}
+
+	for addr, dProvider := range m {
+		if normalizeStatus(dProvider.Status) == hubtypes.StatusInactive.String() {
+			continue
+		}
+
+		drifts = append(drifts, newStaleDrift(database.ProviderCollectionName, addr, bson.M{"addr": addr}, bson.M{"status": hubtypes.StatusInactive.String()}))
+	}
+
+	return drifts, nil
+}
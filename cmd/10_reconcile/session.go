@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"strconv"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/querier"
+	"github.com/sentinel-official/explorer/types"
+)
+
+// reconcileSessions only loads the sessions which are not inactive, since the
+// inactive sessions are removed from the chain state. A session which is known
+// to the chain but not loaded is looked up on its own.
+func reconcileSessions(ctx context.Context, db *mongo.Database, q *querier.Querier, height int64) (drifts []*Drift, err error) {
+	items, err := q.QuerySessions(ctx, pageLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{
+		"status": bson.M{
+			"$in": []string{
+				hubtypes.StatusActive.String(),
+				hubtypes.StatusInactivePending.String(),
+			},
+		},
+	}
+
+	dSessions, err := database.SessionFind(ctx, db, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[uint64]*models.Session)
+	for i := 0; i < len(dSessions); i++ {
+		m[dSessions[i].ID] = dSessions[i]
+	}
+
+	for _, item := range items {
+		var (
+			key       = strconv.FormatUint(item.ID, 10)
+			bandwidth = types.NewBandwidth(&item.Bandwidth)
+			status    = item.Status.String()
+		)
+
+		dSession, ok := m[item.ID]
+		if !ok {
+			dSession, err = database.SessionFindOne(ctx, db, bson.M{"id": item.ID})
+			if err != nil {
+				return nil, err
+			}
+		}
+		if dSession == nil {
+			drifts = append(drifts, newMissingDrift(database.SessionCollectionName, key, &models.Session{
+				ID:              item.ID,
+				SubscriptionID:  item.SubscriptionID,
+				AccAddr:         item.Address,
+				NodeAddr:        item.NodeAddress,
+				Bandwidth:       bandwidth,
+				Duration:        item.Duration.Nanoseconds(),
+				Status:          status,
+				StatusHeight:    height,
+				StatusTimestamp: item.StatusAt,
+			}))
+			continue
+		}
+
+		delete(m, item.ID)
+
+		dBandwidth := dSession.Bandwidth
+		if dBandwidth == nil {
+			dBandwidth = &types.Bandwidth{}
+		}
+
+		d := newDiffer()
+		d.compare("subscription_id", strconv.FormatUint(dSession.SubscriptionID, 10), strconv.FormatUint(item.SubscriptionID, 10), item.SubscriptionID)
+		d.compare("node_addr", dSession.NodeAddr, item.NodeAddress, item.NodeAddress)
+		d.compare("acc_addr", dSession.AccAddr, item.Address, item.Address)
+		d.compare("bandwidth.upload", dBandwidth.Upload, bandwidth.Upload, bandwidth.Upload)
+		d.compare("bandwidth.download", dBandwidth.Download, bandwidth.Download, bandwidth.Download)
+		d.compare("duration", strconv.FormatInt(dSession.Duration, 10), strconv.FormatInt(item.Duration.Nanoseconds(), 10), item.Duration.Nanoseconds())
+		d.compare("status", normalizeStatus(dSession.Status), status, status)
+
+		if drift := d.drift(database.SessionCollectionName, key, bson.M{"id": item.ID}); drift != nil {
+			drifts = append(drifts, drift)
+		}
+	}
+
+	for id := range m {
+		drifts = append(drifts, newStaleDrift(database.SessionCollectionName, strconv.FormatUint(id, 10), bson.M{"id": id}, bson.M{"status": hubtypes.StatusInactive.String()}))
+	}
+
+	return drifts, nil
+}
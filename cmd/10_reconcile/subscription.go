@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+	subscriptiontypes "github.com/sentinel-official/hub/x/subscription/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/querier"
+	"github.com/sentinel-official/explorer/types"
+)
+
+// newSubscription returns the document of a subscription as held by the chain,
+// with the fields which are not part of the chain state left empty.
+func newSubscription(v subscriptiontypes.Subscription, height int64) *models.Subscription {
+	item := &models.Subscription{
+		ID:              v.GetID(),
+		AccAddr:         v.GetAddress().String(),
+		InactiveAt:      v.GetInactiveAt(),
+		Status:          v.GetStatus().String(),
+		StatusHeight:    height,
+		StatusTimestamp: v.GetStatusAt(),
+	}
+
+	switch s := v.(type) {
+	case *subscriptiontypes.NodeSubscription:
+		item.NodeAddr = s.NodeAddress
+		item.Gigabytes = s.Gigabytes
+		item.Hours = s.Hours
+		item.Deposit = types.NewCoin(&s.Deposit)
+	case *subscriptiontypes.PlanSubscription:
+		item.PlanID = s.PlanID
+	}
+
+	return item
+}
+
+// reconcileSubscriptions only loads the subscriptions which are not inactive,
+// since the inactive subscriptions are removed from the chain state.
+func reconcileSubscriptions(ctx context.Context, db *mongo.Database, q *querier.Querier, height int64) (drifts []*Drift, err error) {
+	items, err := q.QuerySubscriptions(ctx, pageLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{
+		"status": bson.M{
+			"$in": []string{
+				hubtypes.StatusActive.String(),
+				hubtypes.StatusInactivePending.String(),
+			},
+		},
+	}
+
+	dSubscriptions, err := database.SubscriptionFind(ctx, db, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[uint64]*models.Subscription)
+	for i := 0; i < len(dSubscriptions); i++ {
+		m[dSubscriptions[i].ID] = dSubscriptions[i]
+	}
+
+	for _, item := range items {
+		var (
+			id    = item.GetID()
+			key   = strconv.FormatUint(id, 10)
+			chain = newSubscription(item, height)
+		)
+
+		dSubscription, ok := m[id]
+		if !ok {
+			dSubscription, err = database.SubscriptionFindOne(ctx, db, bson.M{"id": id})
+			if err != nil {
+				return nil, err
+			}
+		}
+		if dSubscription == nil {
+			drifts = append(drifts, newMissingDrift(database.SubscriptionCollectionName, key, chain))
+			continue
+		}
+
+		delete(m, id)
+
+		d := newDiffer()
+		d.compare("acc_addr", dSubscription.AccAddr, chain.AccAddr, chain.AccAddr)
+		d.compare("inactive_at", dSubscription.InactiveAt.UTC().Format(time.RFC3339Nano), chain.InactiveAt.UTC().Format(time.RFC3339Nano), chain.InactiveAt)
+		d.compare("status", normalizeStatus(dSubscription.Status), chain.Status, chain.Status)
+		d.compare("node_addr", dSubscription.NodeAddr, chain.NodeAddr, chain.NodeAddr)
+		d.compare("gigabytes", strconv.FormatInt(dSubscription.Gigabytes, 10), strconv.FormatInt(chain.Gigabytes, 10), chain.Gigabytes)
+		d.compare("hours", strconv.FormatInt(dSubscription.Hours, 10), strconv.FormatInt(chain.Hours, 10), chain.Hours)
+		d.compare("deposit", coinString(dSubscription.Deposit), coinString(chain.Deposit), chain.Deposit)
+		d.compare("plan_id", strconv.FormatUint(dSubscription.PlanID, 10), strconv.FormatUint(chain.PlanID, 10), chain.PlanID)
+
+		if drift := d.drift(database.SubscriptionCollectionName, key, bson.M{"id": id}); drift != nil {
+			drifts = append(drifts, drift)
+		}
+	}
+
+	for id := range m {
+		drifts = append(drifts, newStaleDrift(database.SubscriptionCollectionName, strconv.FormatUint(id, 10), bson.M{"id": id}, bson.M{"status": hubtypes.StatusInactive.String()}))
+	}
+
+	return drifts, nil
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"strings"
+
+	"github.com/sentinel-official/hub/app"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/migrations"
+	"github.com/sentinel-official/explorer/querier"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const appName = "11_bootstrap"
+
+var (
+	height      int64
+	genesisFile string
+	appNames    string
+	pageLimit   uint64
+	rpcAddress  string
+	dbAddress   string
+	dbName      string
+	dbUsername  string
+	dbPassword  string
+)
+
+func init() {
+	log.SetFlags(0)
+
+	flag.Int64Var(&height, "height", 0, "")
+	flag.StringVar(&genesisFile, "genesis-file", "", "")
+	// The accounts and the staking state of 02_cosmos-sdk are not bootstrapped,
+	// so it is left to index from the genesis unless given explicitly.
+	flag.StringVar(&appNames, "app-names", "01_tendermint,03_sentinelhub", "")
+	flag.Uint64Var(&pageLimit, "page-limit", 1000, "")
+	flag.StringVar(&rpcAddress, "rpc-address", "http://127.0.0.1:26657", "")
+	flag.StringVar(&dbAddress, "db-address", "mongodb://127.0.0.1:27017", "")
+	flag.StringVar(&dbName, "db-name", "sentinelhub-2", "")
+	flag.StringVar(&dbUsername, "db-username", "", "")
+	flag.StringVar(&dbPassword, "db-password", "", "")
+	flag.Parse()
+}
+
+// setSyncStatuses moves the apps to the height of the state, so that they
+// continue from the next height once they are started.
+func setSyncStatuses(ctx context.Context, db *mongo.Database, s *State, names []string) error {
+	for _, name := range names {
+		filter := bson.M{
+			"app_name": name,
+		}
+		update := bson.M{
+			"$set": bson.M{
+				"height":    s.Height,
+				"timestamp": s.Timestamp,
+			},
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+
+		_, err := database.SyncStatusFindOneAndUpdate(ctx, db, filter, update, options.FindOneAndUpdate().SetProjection(projection).SetUpsert(true))
+		if err != nil {
+			return err
+		}
+
+		log.Println("SyncStatus", name, "Height", s.Height)
+	}
+
+	return nil
+}
+
+func main() {
+	if pageLimit == 0 {
+		log.Fatalln("page-limit must be greater than zero")
+	}
+	if genesisFile == "" && height <= 0 {
+		log.Fatalln("either genesis-file or height must be given")
+	}
+
+	var (
+		encCfg = app.DefaultEncodingConfig()
+		state  *State
+		err    error
+	)
+
+	if genesisFile != "" {
+		state, err = NewStateFromGenesisFile(encCfg, genesisFile)
+		if err != nil {
+			log.Fatalln(err)
+		}
+	} else {
+		q, err := querier.NewQuerier(encCfg.InterfaceRegistry, strings.Split(rpcAddress, ","), "/websocket")
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+		state, err = NewStateFromQuerier(context.TODO(), q, height, pageLimit)
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}
+
+	if state.Height <= 0 {
+		log.Fatalln("height of the state must be greater than zero")
+	}
+
+	log.Println("Height", state.Height, "Timestamp", state.Timestamp)
+
+	db, err := utils.PrepareDatabase(context.TODO(), appName, dbUsername, dbPassword, dbAddress, dbName)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if err := db.Client().Ping(context.TODO(), nil); err != nil {
+		log.Fatalln(err)
+	}
+
+	// The indexes are created before seeding, so that the upserts are not
+	// collection scans and the unique constraints hold.
+	if err := migrations.CreateIndexes(context.TODO(), db); err != nil {
+		log.Fatalln(err)
+	}
+
+	steps := []struct {
+		Collection string
+		Models     func(*State) []mongo.WriteModel
+		BulkWrite  func(context.Context, *mongo.Database, []mongo.WriteModel, ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error)
+	}{
+		{database.NodeCollectionName, nodeModels, database.NodeBulkWrite},
+		{database.ProviderCollectionName, providerModels, database.ProviderBulkWrite},
+		{database.PlanCollectionName, planModels, database.PlanBulkWrite},
+		{database.DepositCollectionName, depositModels, database.DepositBulkWrite},
+		{database.EventCollectionName, depositEventModels, database.EventBulkWrite},
+		{database.SubscriptionCollectionName, subscriptionModels, database.SubscriptionBulkWrite},
+		{database.SubscriptionAllocationCollectionName, subscriptionAllocationModels, database.SubscriptionAllocationBulkWrite},
+		{database.SessionCollectionName, sessionModels, database.SessionBulkWrite},
+		{database.ProposalCollectionName, proposalModels, database.ProposalBulkWrite},
+		{database.DenomTraceCollectionName, denomTraceModels, database.DenomTraceBulkWrite},
+	}
+
+	// The writes are upserts keyed by the chain identifiers, so an interrupted
+	// bootstrap can simply be run again. The sync statuses are moved last.
+	for _, step := range steps {
+		models := step.Models(state)
+		if len(models) == 0 {
+			log.Println("Step", step.Collection, "Documents", 0)
+			continue
+		}
+
+		result, err := step.BulkWrite(context.TODO(), db, models, options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			log.Fatalln(step.Collection, err)
+		}
+
+		log.Println("Step", step.Collection, "Documents", len(models), "Upserted", result.UpsertedCount, "Modified", result.ModifiedCount)
+	}
+
+	if err := setSyncStatuses(context.TODO(), db, state, strings.Split(appNames, ",")); err != nil {
+		log.Fatalln(err)
+	}
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"log"
+
+	subscriptiontypes "github.com/sentinel-official/hub/x/subscription/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+// The write models only set the fields held by the chain state, so that the
+// fields maintained by the other apps survive a bootstrap over an existing
+// database. A document which does not exist yet is inserted.
+
+func newUpsertModel(filter, set bson.M) mongo.WriteModel {
+	return mongo.NewUpdateOneModel().
+		SetFilter(filter).
+		SetUpdate(bson.M{"$set": set}).
+		SetUpsert(true)
+}
+
+func depositModels(s *State) (items []mongo.WriteModel) {
+	for _, v := range s.Deposits {
+		items = append(items, newUpsertModel(
+			bson.M{"addr": v.Address},
+			bson.M{
+				"addr":      v.Address,
+				"coins":     types.NewCoins(v.Coins),
+				"height":    s.Height,
+				"timestamp": s.Timestamp,
+			},
+		))
+	}
+
+	return items
+}
+
+// depositEventModels writes a snapshot event for each deposit, from which the
+// rollback rebuilds the deposits, as they have no earlier events.
+func depositEventModels(s *State) (items []mongo.WriteModel) {
+	for _, v := range s.Deposits {
+		items = append(items, newUpsertModel(
+			bson.M{
+				"type":     types.EventTypeDepositSnapshot,
+				"acc_addr": v.Address,
+				"height":   s.Height,
+			},
+			bson.M{
+				"type":      types.EventTypeDepositSnapshot,
+				"height":    s.Height,
+				"timestamp": s.Timestamp,
+				"tx_hash":   "",
+				"acc_addr":  v.Address,
+				"coins":     types.NewCoins(v.Coins),
+			},
+		))
+	}
+
+	return items
+}
+
+func nodeModels(s *State) (items []mongo.WriteModel) {
+	for _, v := range s.Nodes {
+		items = append(items, newUpsertModel(
+			bson.M{"addr": v.Address},
+			bson.M{
+				"addr":             v.Address,
+				"gigabyte_prices":  types.NewCoins(v.GigabytePrices),
+				"hourly_prices":    types.NewCoins(v.HourlyPrices),
+				"remote_url":       v.RemoteURL,
+				"status":           v.Status.String(),
+				"status_height":    s.Height,
+				"status_timestamp": v.StatusAt,
+			},
+		))
+	}
+
+	return items
+}
+
+func planModels(s *State) (items []mongo.WriteModel) {
+	for _, v := range s.Plans {
+		nodeAddrs := v.Nodes
+		if nodeAddrs == nil {
+			nodeAddrs = []string{}
+		}
+
+		items = append(items, newUpsertModel(
+			bson.M{"id": v.Plan.ID},
+			bson.M{
+				"id":               v.Plan.ID,
+				"prov_addr":        v.Plan.ProviderAddress,
+				"duration":         v.Plan.Duration.Nanoseconds(),
+				"gigabytes":        v.Plan.Gigabytes,
+				"prices":           types.NewCoins(v.Plan.Prices),
+				"node_addrs":       nodeAddrs,
+				"status":           v.Plan.Status.String(),
+				"status_height":    s.Height,
+				"status_timestamp": v.Plan.StatusAt,
+			},
+		))
+	}
+
+	return items
+}
+
+func providerModels(s *State) (items []mongo.WriteModel) {
+	for _, v := range s.Providers {
+		items = append(items, newUpsertModel(
+			bson.M{"addr": v.Address},
+			bson.M{
+				"addr":             v.Address,
+				"name":             v.Name,
+				"identity":         v.Identity,
+				"website":          v.Website,
+				"description":      v.Description,
+				"status":           v.Status.String(),
+				"status_height":    s.Height,
+				"status_timestamp": v.StatusAt,
+			},
+		))
+	}
+
+	return items
+}
+
+func sessionModels(s *State) (items []mongo.WriteModel) {
+	for _, v := range s.Sessions {
+		items = append(items, newUpsertModel(
+			bson.M{"id": v.ID},
+			bson.M{
+				"id":               v.ID,
+				"subscription_id":  v.SubscriptionID,
+				"acc_addr":         v.Address,
+				"node_addr":        v.NodeAddress,
+				"bandwidth":        types.NewBandwidth(&v.Bandwidth),
+				"duration":         v.Duration.Nanoseconds(),
+				"status":           v.Status.String(),
+				"status_height":    s.Height,
+				"status_timestamp": v.StatusAt,
+			},
+		))
+	}
+
+	return items
+}
+
+func subscriptionModels(s *State) (items []mongo.WriteModel) {
+	for _, v := range s.Subscriptions {
+		set := bson.M{
+			"id":               v.Subscription.GetID(),
+			"acc_addr":         v.Subscription.GetAddress().String(),
+			"inactive_at":      v.Subscription.GetInactiveAt(),
+			"status":           v.Subscription.GetStatus().String(),
+			"status_height":    s.Height,
+			"status_timestamp": v.Subscription.GetStatusAt(),
+		}
+
+		switch subscription := v.Subscription.(type) {
+		case *subscriptiontypes.NodeSubscription:
+			set["node_addr"] = subscription.NodeAddress
+			set["gigabytes"] = subscription.Gigabytes
+			set["hours"] = subscription.Hours
+			set["deposit"] = types.NewCoin(&subscription.Deposit)
+		case *subscriptiontypes.PlanSubscription:
+			set["plan_id"] = subscription.PlanID
+		}
+
+		items = append(items, newUpsertModel(bson.M{"id": v.Subscription.GetID()}, set))
+	}
+
+	return items
+}
+
+func subscriptionAllocationModels(s *State) (items []mongo.WriteModel) {
+	for _, v := range s.Subscriptions {
+		for _, allocation := range v.Allocations {
+			items = append(items, newUpsertModel(
+				bson.M{"id": allocation.ID, "acc_addr": allocation.Address},
+				bson.M{
+					"id":             allocation.ID,
+					"acc_addr":       allocation.Address,
+					"granted_bytes":  allocation.GrantedBytes.String(),
+					"utilised_bytes": allocation.UtilisedBytes.String(),
+				},
+			))
+		}
+	}
+
+	return items
+}
+
+func denomTraceModels(s *State) (items []mongo.WriteModel) {
+	for _, v := range s.DenomTraces {
+		items = append(items, newUpsertModel(
+			bson.M{"hash": v.Hash().String()},
+			bson.M{
+				"hash":       v.Hash().String(),
+				"path":       v.Path,
+				"base_denom": v.BaseDenom,
+			},
+		))
+	}
+
+	return items
+}
+
+func proposalModels(s *State) (items []mongo.WriteModel) {
+	for i := range s.Proposals {
+		v, err := models.NewProposalFromGovProposal(&s.Proposals[i])
+		if err != nil {
+			log.Println("Proposal", s.Proposals[i].ProposalId, err)
+			continue
+		}
+
+		set := bson.M{
+			"id":               v.ID,
+			"type":             v.Type,
+			"title":            v.Title,
+			"description":      v.Description,
+			"content":          v.Content,
+			"total_deposit":    v.TotalDeposit,
+			"submit_timestamp": v.SubmitTimestamp,
+			"status":           v.Status,
+			"status_height":    s.Height,
+			"status_timestamp": s.Timestamp,
+		}
+		if !v.VotingStartTimestamp.IsZero() {
+			set["voting_start_timestamp"] = v.VotingStartTimestamp
+		}
+
+		items = append(items, newUpsertModel(bson.M{"id": v.ID}, set))
+	}
+
+	return items
+}
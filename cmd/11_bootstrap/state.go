@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	"github.com/sentinel-official/hub/app"
+	deposittypes "github.com/sentinel-official/hub/x/deposit/types"
+	nodetypes "github.com/sentinel-official/hub/x/node/types"
+	plantypes "github.com/sentinel-official/hub/x/plan/types"
+	providertypes "github.com/sentinel-official/hub/x/provider/types"
+	sessiontypes "github.com/sentinel-official/hub/x/session/types"
+	subscriptiontypes "github.com/sentinel-official/hub/x/subscription/types"
+	vpntypes "github.com/sentinel-official/hub/x/vpn/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/sentinel-official/explorer/querier"
+)
+
+type Subscription struct {
+	Subscription subscriptiontypes.Subscription
+	Allocations  []subscriptiontypes.Allocation
+}
+
+// State is the state of the VPN modules, the open proposals of the gov module
+// and the denom traces of the transfer module at a height, as read from either an exported genesis file or the gRPC
+// services of a node.
+type State struct {
+	Height        int64
+	Timestamp     time.Time
+	Deposits      []deposittypes.Deposit
+	Nodes         []nodetypes.Node
+	Plans         []plantypes.GenesisPlan
+	Providers     []providertypes.Provider
+	Sessions      []sessiontypes.Session
+	Subscriptions []Subscription
+	Proposals     []govtypes.Proposal
+	DenomTraces   []transfertypes.DenomTrace
+}
+
+// isOpenProposal reports whether the proposal is still in its deposit or voting
+// period, the ones which can be updated by the heights to index.
+func isOpenProposal(v govtypes.Proposal) bool {
+	return v.Status == govtypes.StatusDepositPeriod || v.Status == govtypes.StatusVotingPeriod
+}
+
+// NewStateFromGenesisFile reads the state from the app state of an exported
+// genesis file. An exported genesis starts at the height next to the one it
+// was exported at, which is the height of the state.
+func NewStateFromGenesisFile(encCfg app.EncodingConfig, path string) (*State, error) {
+	doc, err := tmtypes.GenesisDocFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(doc.AppState, &appState); err != nil {
+		return nil, err
+	}
+
+	bz, ok := appState[vpntypes.ModuleName]
+	if !ok {
+		return nil, fmt.Errorf("app state of module %s does not exist", vpntypes.ModuleName)
+	}
+
+	var genesis vpntypes.GenesisState
+	if err := encCfg.Codec.UnmarshalJSON(bz, &genesis); err != nil {
+		return nil, err
+	}
+
+	state := &State{
+		Height:    doc.InitialHeight - 1,
+		Timestamp: doc.GenesisTime,
+		Deposits:  genesis.Deposits,
+		Plans:     genesis.Plans,
+	}
+	if genesis.Nodes != nil {
+		state.Nodes = genesis.Nodes.Nodes
+	}
+	if genesis.Providers != nil {
+		state.Providers = genesis.Providers.Providers
+	}
+	if genesis.Sessions != nil {
+		state.Sessions = genesis.Sessions.Sessions
+	}
+	if genesis.Subscriptions != nil {
+		for _, item := range genesis.Subscriptions.Subscriptions {
+			var subscription subscriptiontypes.Subscription
+			if err := encCfg.InterfaceRegistry.UnpackAny(item.Subscription, &subscription); err != nil {
+				return nil, err
+			}
+
+			state.Subscriptions = append(state.Subscriptions, Subscription{
+				Subscription: subscription,
+				Allocations:  item.Allocations,
+			})
+		}
+	}
+
+	if bz, ok := appState[govtypes.ModuleName]; ok {
+		var genesis govtypes.GenesisState
+		if err := encCfg.Codec.UnmarshalJSON(bz, &genesis); err != nil {
+			return nil, err
+		}
+
+		for _, item := range genesis.Proposals {
+			if isOpenProposal(item) {
+				state.Proposals = append(state.Proposals, item)
+			}
+		}
+	}
+
+	if bz, ok := appState[transfertypes.ModuleName]; ok {
+		var genesis transfertypes.GenesisState
+		if err := encCfg.Codec.UnmarshalJSON(bz, &genesis); err != nil {
+			return nil, err
+		}
+
+		state.DenomTraces = genesis.DenomTraces
+	}
+
+	return state, nil
+}
+
+// NewStateFromQuerier queries the state at the height, listing the nodes of
+// each plan and the allocations of each subscription one at a time.
+func NewStateFromQuerier(ctx context.Context, q *querier.Querier, height int64, limit uint64) (state *State, err error) {
+	block, err := q.QueryBlock(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	state = &State{
+		Height:    height,
+		Timestamp: block.Block.Time,
+	}
+
+	ctx = querier.ContextWithHeight(ctx, height)
+	if state.Deposits, err = q.QueryDeposits(ctx, limit); err != nil {
+		return nil, err
+	}
+	if state.Nodes, err = q.QueryNodes(ctx, limit); err != nil {
+		return nil, err
+	}
+	if state.Providers, err = q.QueryProviders(ctx, limit); err != nil {
+		return nil, err
+	}
+	if state.Sessions, err = q.QuerySessions(ctx, limit); err != nil {
+		return nil, err
+	}
+	if state.DenomTraces, err = q.QueryDenomTraces(ctx, limit); err != nil {
+		return nil, err
+	}
+
+	for _, status := range []govtypes.ProposalStatus{govtypes.StatusDepositPeriod, govtypes.StatusVotingPeriod} {
+		proposals, err := q.QueryProposals(ctx, status, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		state.Proposals = append(state.Proposals, proposals...)
+	}
+
+	plans, err := q.QueryPlans(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, plan := range plans {
+		nodes, err := q.QueryNodesForPlan(ctx, plan.ID, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		item := plantypes.GenesisPlan{
+			Plan:  plan,
+			Nodes: make([]string, 0, len(nodes)),
+		}
+		for _, node := range nodes {
+			item.Nodes = append(item.Nodes, node.Address)
+		}
+
+		state.Plans = append(state.Plans, item)
+	}
+
+	subscriptions, err := q.QuerySubscriptions(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, subscription := range subscriptions {
+		allocations, err := q.QueryAllocations(ctx, subscription.GetID(), limit)
+		if err != nil {
+			return nil, err
+		}
+
+		state.Subscriptions = append(state.Subscriptions, Subscription{
+			Subscription: subscription,
+			Allocations:  allocations,
+		})
+	}
+
+	return state, nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/sentinel-official/explorer/migrations"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const appName = "12_migrate"
+
+var (
+	action     string
+	toVersion  int64
+	dryRun     bool
+	dbAddress  string
+	dbName     string
+	dbUsername string
+	dbPassword string
+)
+
+func init() {
+	log.SetFlags(0)
+
+	flag.StringVar(&action, "action", "status", "")
+	flag.Int64Var(&toVersion, "to-version", -1, "")
+	flag.BoolVar(&dryRun, "dry-run", false, "")
+	flag.StringVar(&dbAddress, "db-address", "mongodb://127.0.0.1:27017", "")
+	flag.StringVar(&dbName, "db-name", "sentinelhub-2", "")
+	flag.StringVar(&dbUsername, "db-username", "", "")
+	flag.StringVar(&dbPassword, "db-password", "", "")
+	flag.Parse()
+}
+
+func main() {
+	db, err := utils.PrepareDatabase(context.TODO(), appName, dbUsername, dbPassword, dbAddress, dbName)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if err := db.Client().Ping(context.TODO(), nil); err != nil {
+		log.Fatalln(err)
+	}
+
+	statuses, err := migrations.Statuses(context.TODO(), db)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	var items []*migrations.Migration
+	switch action {
+	case "status":
+		for _, status := range statuses {
+			if status.Applied == nil {
+				log.Println("Migration", status.Migration, "Pending")
+				continue
+			}
+			if status.Applied.Skipped {
+				log.Println("Migration", status.Migration, "SkippedAt", status.Applied.AppliedAt)
+				continue
+			}
+
+			log.Println("Migration", status.Migration, "AppliedAt", status.Applied.AppliedAt)
+		}
+
+		return
+	case "up":
+		// A negative version applies every pending migration.
+		target := uint64(0)
+		if toVersion >= 0 {
+			target = uint64(toVersion)
+		}
+
+		items, err = migrations.Up(context.TODO(), db, target, dryRun)
+	case "down":
+		// A negative version reverts the latest applied migration only.
+		target := uint64(0)
+		if toVersion >= 0 {
+			target = uint64(toVersion)
+		} else {
+			for _, status := range statuses {
+				if status.Applied != nil {
+					target = status.Migration.Version - 1
+				}
+			}
+		}
+
+		items, err = migrations.Down(context.TODO(), db, target, dryRun)
+	default:
+		log.Fatalln("action must be one of status, up or down")
+	}
+
+	for _, item := range items {
+		log.Println("Migration", item, "Action", action, "DryRun", dryRun)
+	}
+	if err != nil {
+		log.Fatalln(err)
+	}
+}
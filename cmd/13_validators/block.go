@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/querier"
+)
+
+var (
+	flagAbsent = fmt.Sprintf("%v", tmtypes.BlockIDFlagAbsent)
+)
+
+// validatorSets caches the validator set of the last seen validators hash, since
+// the set rarely changes between the heights.
+type validatorSets struct {
+	hash  string
+	items []*tmtypes.Validator
+}
+
+func (s *validatorSets) Get(ctx context.Context, q *querier.Querier, hash string, height int64) ([]*tmtypes.Validator, error) {
+	if hash != "" && hash == s.hash {
+		return s.items, nil
+	}
+
+	items, err := q.QueryValidators(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	s.hash, s.items = hash, items
+	return s.items, nil
+}
+
+// validatorUpdate accumulates the changes of a validator at a height, so that
+// a single update is written per validator.
+type validatorUpdate struct {
+	inc         bson.M
+	inSet       bool
+	votingPower int64
+}
+
+func (u *validatorUpdate) Inc(key string, v int64) {
+	if u.inc == nil {
+		u.inc = bson.M{}
+	}
+
+	n, _ := u.inc[key].(int64)
+	u.inc[key] = n + v
+}
+
+func (u *validatorUpdate) Model(consAddr string, height int64) mongo.WriteModel {
+	filter := bson.M{
+		"cons_addr": consAddr,
+	}
+	update := bson.M{}
+	if len(u.inc) > 0 {
+		update["$inc"] = u.inc
+	}
+	if u.inSet {
+		update["$set"] = bson.M{
+			"voting_power": u.votingPower,
+		}
+		update["$min"] = bson.M{
+			"start_height": height,
+		}
+		update["$max"] = bson.M{
+			"last_height": height,
+		}
+	}
+
+	return mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update).SetUpsert(true)
+}
+
+// run returns the models of the validators and validator blocks for the height,
+// along with the consensus addresses of the validator set. The signatures of a
+// block are written while indexing the next one, so the caller has to make sure
+// that the next block exists.
+func run(ctx context.Context, db *mongo.Database, q *querier.Querier, sets *validatorSets, height int64) (
+	consAddrs []string, vModels, bModels []mongo.WriteModel, err error,
+) {
+	filter := bson.M{
+		"height": height,
+	}
+	projection := bson.M{
+		"height":           1,
+		"proposer_address": 1,
+		"signatures":       1,
+		"time":             1,
+		"validators_hash":  1,
+	}
+
+	dBlock, err := database.BlockFindOne(ctx, db, filter, options.FindOne().SetProjection(projection))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if dBlock == nil {
+		return nil, nil, nil, fmt.Errorf("block %d does not exist", height)
+	}
+
+	qValidators, err := sets.Get(ctx, q, dBlock.ValidatorsHash, height)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(qValidators) != len(dBlock.Signatures) {
+		return nil, nil, nil, fmt.Errorf("block %d has %d signatures for %d validators", height, len(dBlock.Signatures), len(qValidators))
+	}
+
+	updates := make(map[string]*validatorUpdate)
+	get := func(consAddr string) *validatorUpdate {
+		if _, ok := updates[consAddr]; !ok {
+			updates[consAddr] = &validatorUpdate{}
+		}
+
+		return updates[consAddr]
+	}
+
+	for i, v := range qValidators {
+		var (
+			consAddr = v.Address.String()
+			missed   = dBlock.Signatures[i].Flag == flagAbsent
+			proposed = consAddr == dBlock.ProposerAddress
+			u        = get(consAddr)
+		)
+
+		consAddrs = append(consAddrs, consAddr)
+		u.inSet, u.votingPower = true, v.VotingPower
+
+		if missed {
+			u.Inc("missed_blocks", 1)
+			for _, w := range windows {
+				u.Inc("uptime_windows."+strconv.FormatInt(w, 10), 1)
+			}
+		} else {
+			u.Inc("signed_blocks", 1)
+		}
+		if proposed {
+			u.Inc("proposed_blocks", 1)
+		}
+
+		if missed || proposed {
+			filter := bson.M{
+				"cons_addr": consAddr,
+				"height":    height,
+			}
+			update := bson.M{
+				"$set": &models.ValidatorBlock{
+					ConsAddr:  consAddr,
+					Height:    height,
+					Timestamp: dBlock.Time,
+					Proposed:  proposed,
+					Missed:    missed,
+				},
+			}
+
+			bModels = append(bModels, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update).SetUpsert(true))
+		}
+	}
+
+	// The blocks missed at the height the windows have just moved past no
+	// longer count towards them.
+	for _, w := range windows {
+		if height-w < 1 {
+			continue
+		}
+
+		filter := bson.M{
+			"height": height - w,
+			"missed": true,
+		}
+		projection := bson.M{
+			"cons_addr": 1,
+		}
+
+		dBlocks, err := database.ValidatorBlockFind(ctx, db, filter, options.Find().SetProjection(projection))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		for _, item := range dBlocks {
+			get(item.ConsAddr).Inc("uptime_windows."+strconv.FormatInt(w, 10), -1)
+		}
+	}
+
+	for consAddr, u := range updates {
+		vModels = append(vModels, u.Model(consAddr, height))
+	}
+
+	log.Println("ValidatorsLen", len(vModels), "ValidatorBlocksLen", len(bModels))
+	return consAddrs, vModels, bModels, nil
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sentinel-official/hub/app"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/migrations"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/querier"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	appName = "13_validators"
+)
+
+var (
+	fromHeight          int64
+	toHeight            int64
+	rpcAddress          string
+	dbAddress           string
+	dbName              string
+	dbUsername          string
+	dbPassword          string
+	pollInterval        time.Duration
+	uptimeWindows       string
+	descriptionInterval int64
+	pageLimit           uint64
+
+	windows []int64
+)
+
+func init() {
+	log.SetFlags(0)
+
+	flag.Int64Var(&fromHeight, "from-height", 12_310_005, "")
+	flag.Int64Var(&toHeight, "to-height", math.MaxInt64, "")
+	flag.StringVar(&rpcAddress, "rpc-address", "http://127.0.0.1:26657", "")
+	flag.StringVar(&dbAddress, "db-address", "mongodb://127.0.0.1:27017", "")
+	flag.StringVar(&dbName, "db-name", "sentinelhub-2", "")
+	flag.StringVar(&dbUsername, "db-username", "", "")
+	flag.StringVar(&dbPassword, "db-password", "", "")
+	flag.DurationVar(&pollInterval, "poll-interval", 5*time.Second, "")
+	flag.StringVar(&uptimeWindows, "uptime-windows", "100,1000,10000", "")
+	flag.Int64Var(&descriptionInterval, "description-interval", 1000, "")
+	flag.Uint64Var(&pageLimit, "page-limit", 1000, "")
+	flag.Parse()
+}
+
+func createIndexes(ctx context.Context, db *mongo.Database) error {
+	return migrations.CreateIndexes(
+		ctx, db,
+		database.SyncStatusCollectionName,
+		database.BlockCollectionName,
+		database.ValidatorCollectionName,
+		database.ValidatorBlockCollectionName,
+	)
+}
+
+// parseWindows parses the comma separated sizes of the uptime windows.
+func parseWindows(s string) (items []int64, err error) {
+	for _, item := range strings.Split(s, ",") {
+		v, err := strconv.ParseInt(strings.TrimSpace(item), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if v <= 0 {
+			return nil, fmt.Errorf("uptime window %d must be greater than zero", v)
+		}
+
+		items = append(items, v)
+	}
+
+	sort.Slice(items, func(i, j int) bool { return items[i] < items[j] })
+	return items, nil
+}
+
+// syncedHeight returns the height up to which the blocks have been indexed.
+func syncedHeight(ctx context.Context, db *mongo.Database) (int64, error) {
+	filter := bson.M{
+		"app_name": "01_tendermint",
+	}
+
+	dSyncStatus, err := database.SyncStatusFindOne(ctx, db, filter)
+	if err != nil {
+		return 0, err
+	}
+	if dSyncStatus == nil {
+		return 0, nil
+	}
+
+	return dSyncStatus.Height, nil
+}
+
+// describedConsAddrs returns the consensus addresses of the validators which
+// already have their operator address set.
+func describedConsAddrs(ctx context.Context, db *mongo.Database) (map[string]bool, error) {
+	filter := bson.M{
+		"oper_addr": bson.M{
+			"$gt": "",
+		},
+	}
+	projection := bson.M{
+		"cons_addr": 1,
+	}
+
+	dValidators, err := database.ValidatorFind(ctx, db, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]bool)
+	for _, item := range dValidators {
+		items[item.ConsAddr] = true
+	}
+
+	return items, nil
+}
+
+// commit writes the models and moves the sync status to the given height within a single transaction.
+func commit(db *mongo.Database, dModels, vModels, bModels []mongo.WriteModel, height int64) error {
+	return db.Client().UseSession(
+		context.TODO(),
+		func(ctx mongo.SessionContext) error {
+			err := ctx.StartTransaction(
+				options.Transaction().
+					SetReadConcern(readconcern.Snapshot()).
+					SetWriteConcern(writeconcern.Majority()),
+			)
+			if err != nil {
+				return err
+			}
+
+			abort := true
+			defer func() {
+				if abort {
+					_ = ctx.AbortTransaction(ctx)
+				}
+			}()
+
+			if len(dModels) > 0 {
+				if _, err := database.ValidatorBulkWrite(ctx, db, dModels); err != nil {
+					return err
+				}
+			}
+			if len(vModels) > 0 {
+				if _, err := database.ValidatorBulkWrite(ctx, db, vModels); err != nil {
+					return err
+				}
+			}
+			if len(bModels) > 0 {
+				if _, err := database.ValidatorBlockBulkWrite(ctx, db, bModels); err != nil {
+					return err
+				}
+			}
+
+			filter := bson.M{
+				"app_name": appName,
+			}
+			update := bson.M{
+				"$set": bson.M{
+					"height": height,
+				},
+			}
+			projection := bson.M{
+				"_id": 1,
+			}
+
+			_, err = database.SyncStatusFindOneAndUpdate(ctx, db, filter, update, options.FindOneAndUpdate().SetProjection(projection).SetUpsert(true))
+			if err != nil {
+				return err
+			}
+
+			abort = false
+			return ctx.CommitTransaction(ctx)
+		},
+	)
+}
+
+func main() {
+	var err error
+	if windows, err = parseWindows(uptimeWindows); err != nil {
+		log.Fatalln(err)
+	}
+	if descriptionInterval < 1 {
+		log.Fatalln("description-interval must be greater than zero")
+	}
+
+	encCfg := app.DefaultEncodingConfig()
+	q, err := querier.NewQuerier(encCfg.InterfaceRegistry, strings.Split(rpcAddress, ","), "/websocket")
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	db, err := utils.PrepareDatabase(context.TODO(), appName, dbUsername, dbPassword, dbAddress, dbName)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if err = db.Client().Ping(context.TODO(), nil); err != nil {
+		log.Fatalln(err)
+	}
+
+	if err := createIndexes(context.TODO(), db); err != nil {
+		log.Fatalln(err)
+	}
+
+	filter := bson.M{
+		"app_name": appName,
+	}
+
+	dSyncStatus, err := database.SyncStatusFindOne(context.TODO(), db, filter)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if dSyncStatus == nil {
+		dSyncStatus = &models.SyncStatus{
+			AppName:   appName,
+			Height:    fromHeight - 1,
+			Timestamp: time.Time{},
+		}
+	}
+
+	described, err := describedConsAddrs(context.TODO(), db)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	var (
+		sets         = &validatorSets{}
+		height       = dSyncStatus.Height + 1
+		latestHeight = int64(0)
+	)
+
+	for height < toHeight {
+		// The signatures of a block are only known once the next block has
+		// been indexed.
+		if height+1 > latestHeight {
+			if latestHeight, err = syncedHeight(context.TODO(), db); err != nil {
+				log.Fatalln(err)
+			}
+			if height+1 > latestHeight {
+				time.Sleep(pollInterval)
+				continue
+			}
+		}
+
+		now := time.Now()
+		log.Println("Height", height)
+
+		consAddrs, vModels, bModels, err := run(context.TODO(), db, q, sets, height)
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+		refresh := height%descriptionInterval == 0
+		for _, consAddr := range consAddrs {
+			if !described[consAddr] {
+				refresh = true
+			}
+		}
+
+		var dModels []mongo.WriteModel
+		if refresh {
+			if described, dModels, err = descriptionModels(context.TODO(), q); err != nil {
+				log.Fatalln(err)
+			}
+
+			// The validators which are no longer known to the staking module
+			// are not looked up again until the next interval.
+			for _, consAddr := range consAddrs {
+				described[consAddr] = true
+			}
+		}
+
+		if err := commit(db, dModels, vModels, bModels, height); err != nil {
+			log.Fatalln(err)
+		}
+
+		log.Println("Duration", time.Since(now))
+		log.Println("")
+		height++
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"github.com/tendermint/tendermint/libs/bytes"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/querier"
+)
+
+// descriptionModels returns the models which upsert the operator address,
+// description and bonding state of every validator of the staking module,
+// keyed by the consensus address used in the commit signatures.
+func descriptionModels(ctx context.Context, q *querier.Querier) (map[string]bool, []mongo.WriteModel, error) {
+	qValidators, err := q.QueryStakingValidators(ctx, pageLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		consAddrs = make(map[string]bool)
+		models    = make([]mongo.WriteModel, 0, len(qValidators))
+	)
+
+	for _, v := range qValidators {
+		addr, err := v.GetConsAddr()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		consAddr := bytes.HexBytes(addr).String()
+		consAddrs[consAddr] = true
+
+		filter := bson.M{
+			"cons_addr": consAddr,
+		}
+		update := bson.M{
+			"$set": bson.M{
+				"oper_addr": v.OperatorAddress,
+				"moniker":   v.Description.Moniker,
+				"identity":  v.Description.Identity,
+				"website":   v.Description.Website,
+				"details":   v.Description.Details,
+				"jailed":    v.Jailed,
+				"status":    v.Status.String(),
+				"tokens":    v.Tokens.String(),
+			},
+		}
+
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update).SetUpsert(true))
+	}
+
+	log.Println("ValidatorDescriptionsLen", len(models))
+	return consAddrs, models, nil
+}
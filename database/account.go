@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	AccountCollectionName = "accounts"
+)
+
+func AccountFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.Account, error) {
+	var v models.Account
+	if err := FindOne(ctx, db.Collection(AccountCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func AccountInsertOne(ctx context.Context, db *mongo.Database, v *models.Account, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(AccountCollectionName), v, opts...)
+}
+
+func AccountFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.Account, error) {
+	var v models.Account
+	if err := FindOneAndUpdate(ctx, db.Collection(AccountCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func AccountFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.Account, error) {
+	var v []*models.Account
+	if err := Find(ctx, db.Collection(AccountCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func AccountFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.Account, *Page, error) {
+	return FindPage[models.Account](ctx, db.Collection(AccountCollectionName), filter, cursor, opts)
+}
+
+func AccountIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(AccountCollectionName), models, opts...)
+}
+
+func AccountDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(AccountCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
 )
 
 const (
@@ -45,6 +46,28 @@ func BlockFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*
 	return v, nil
 }
 
+func BlockFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.Block, *Page, error) {
+	return FindPage[models.Block](ctx, db.Collection(BlockCollectionName), filter, cursor, opts)
+}
+
 func BlockIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
 	return IndexesCreateMany(ctx, db.Collection(BlockCollectionName), models, opts...)
 }
+
+func BlockDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(BlockCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func BlockAggregateAll(ctx context.Context, db *mongo.Database, pipeline []bson.M, opts ...*options.AggregateOptions) ([]bson.M, error) {
+	var v []bson.M
+	if err := AggregateAll(ctx, db.Collection(BlockCollectionName), pipeline, &v, opts...); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
@@ -141,6 +141,15 @@ func CountDocuments(ctx context.Context, c *mongo.Collection, filter bson.M, opt
 	return c.CountDocuments(ctx, filter, opts...)
 }
 
+func EstimatedDocumentCount(ctx context.Context, c *mongo.Collection, opts ...*options.EstimatedDocumentCountOptions) (int64, error) {
+	now := time.Now()
+	defer func() {
+		log.Println(c.Name(), "EstimatedDocumentCount", time.Since(now))
+	}()
+
+	return c.EstimatedDocumentCount(ctx, opts...)
+}
+
 func Distinct(ctx context.Context, c *mongo.Collection, fieldName string, filter bson.M, opts ...*options.DistinctOptions) (bson.A, error) {
 	now := time.Now()
 	defer func() {
@@ -194,3 +203,23 @@ func BulkWrite(ctx context.Context, c *mongo.Collection, models []mongo.WriteMod
 
 	return c.BulkWrite(ctx, models, opts...)
 }
+
+func IndexesDropOne(ctx context.Context, c *mongo.Collection, name string, opts ...*options.DropIndexesOptions) error {
+	now := time.Now()
+	defer func() {
+		log.Println(c.Name(), "IndexesDropOne", time.Since(now))
+	}()
+
+	_, err := c.Indexes().DropOne(ctx, name, opts...)
+	return err
+}
+
+func IndexesDropAll(ctx context.Context, c *mongo.Collection, opts ...*options.DropIndexesOptions) error {
+	now := time.Now()
+	defer func() {
+		log.Println(c.Name(), "IndexesDropAll", time.Since(now))
+	}()
+
+	_, err := c.Indexes().DropAll(ctx, opts...)
+	return err
+}
@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	DelegationCollectionName = "delegations"
+)
+
+func DelegationFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.Delegation, error) {
+	var v models.Delegation
+	if err := FindOne(ctx, db.Collection(DelegationCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func DelegationInsertOne(ctx context.Context, db *mongo.Database, v *models.Delegation, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(DelegationCollectionName), v, opts...)
+}
+
+func DelegationFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.Delegation, error) {
+	var v models.Delegation
+	if err := FindOneAndUpdate(ctx, db.Collection(DelegationCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func DelegationFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.Delegation, error) {
+	var v []*models.Delegation
+	if err := Find(ctx, db.Collection(DelegationCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func DelegationFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.Delegation, *Page, error) {
+	return FindPage[models.Delegation](ctx, db.Collection(DelegationCollectionName), filter, cursor, opts)
+}
+
+func DelegationIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(DelegationCollectionName), models, opts...)
+}
+
+func DelegationCountDocuments(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.CountOptions) (int64, error) {
+	return CountDocuments(ctx, db.Collection(DelegationCollectionName), filter, opts...)
+}
+
+func DelegationDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(DelegationCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
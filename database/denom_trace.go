@@ -0,0 +1,59 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	DenomTraceCollectionName = "denom_traces"
+)
+
+func DenomTraceFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.DenomTrace, error) {
+	var v models.DenomTrace
+	if err := FindOne(ctx, db.Collection(DenomTraceCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func DenomTraceInsertOne(ctx context.Context, db *mongo.Database, v *models.DenomTrace, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(DenomTraceCollectionName), v, opts...)
+}
+
+func DenomTraceFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.DenomTrace, error) {
+	var v models.DenomTrace
+	if err := FindOneAndUpdate(ctx, db.Collection(DenomTraceCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func DenomTraceFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.DenomTrace, error) {
+	var v []*models.DenomTrace
+	if err := Find(ctx, db.Collection(DenomTraceCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func DenomTraceFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.DenomTrace, *Page, error) {
+	return FindPage[models.DenomTrace](ctx, db.Collection(DenomTraceCollectionName), filter, cursor, opts)
+}
+
+func DenomTraceIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(DenomTraceCollectionName), models, opts...)
+}
+
+func DenomTraceBulkWrite(ctx context.Context, db *mongo.Database, models []mongo.WriteModel, opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error) {
+	return BulkWrite(ctx, db.Collection(DenomTraceCollectionName), models, opts...)
+}
@@ -8,6 +8,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
 )
 
 const (
@@ -45,6 +46,23 @@ func DepositFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ..
 	return v, nil
 }
 
+func DepositFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.Deposit, *Page, error) {
+	return FindPage[models.Deposit](ctx, db.Collection(DepositCollectionName), filter, cursor, opts)
+}
+
 func DepositIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
 	return IndexesCreateMany(ctx, db.Collection(DepositCollectionName), models, opts...)
 }
+
+func DepositDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(DepositCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DepositBulkWrite(ctx context.Context, db *mongo.Database, models []mongo.WriteModel, opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error) {
+	return BulkWrite(ctx, db.Collection(DepositCollectionName), models, opts...)
+}
@@ -8,6 +8,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
 )
 
 const (
@@ -45,6 +46,10 @@ func EventFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*
 	return v, nil
 }
 
+func EventFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.Event, *Page, error) {
+	return FindPage[models.Event](ctx, db.Collection(EventCollectionName), filter, cursor, opts)
+}
+
 func EventIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
 	return IndexesCreateMany(ctx, db.Collection(EventCollectionName), models, opts...)
 }
@@ -61,3 +66,20 @@ func EventAggregateAll(ctx context.Context, db *mongo.Database, pipeline []bson.
 
 	return v, nil
 }
+
+func EventBulkWrite(ctx context.Context, db *mongo.Database, models []mongo.WriteModel, opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error) {
+	return BulkWrite(ctx, db.Collection(EventCollectionName), models, opts...)
+}
+
+func EventDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(EventCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func EventDistinct(ctx context.Context, db *mongo.Database, fieldName string, filter bson.M, opts ...*options.DistinctOptions) (bson.A, error) {
+	return Distinct(ctx, db.Collection(EventCollectionName), fieldName, filter, opts...)
+}
@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	IBCTransferCollectionName = "ibc_transfers"
+)
+
+func IBCTransferFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.IBCTransfer, error) {
+	var v models.IBCTransfer
+	if err := FindOne(ctx, db.Collection(IBCTransferCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func IBCTransferInsertOne(ctx context.Context, db *mongo.Database, v *models.IBCTransfer, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(IBCTransferCollectionName), v, opts...)
+}
+
+func IBCTransferFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.IBCTransfer, error) {
+	var v models.IBCTransfer
+	if err := FindOneAndUpdate(ctx, db.Collection(IBCTransferCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func IBCTransferFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.IBCTransfer, error) {
+	var v []*models.IBCTransfer
+	if err := Find(ctx, db.Collection(IBCTransferCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func IBCTransferFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.IBCTransfer, *Page, error) {
+	return FindPage[models.IBCTransfer](ctx, db.Collection(IBCTransferCollectionName), filter, cursor, opts)
+}
+
+func IBCTransferIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(IBCTransferCollectionName), models, opts...)
+}
+
+func IBCTransferDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(IBCTransferCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func IBCTransferUpdateMany(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	return UpdateMany(ctx, db.Collection(IBCTransferCollectionName), filter, update, opts...)
+}
@@ -0,0 +1,72 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	LeaseCollectionName = "leases"
+)
+
+func LeaseFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.Lease, error) {
+	var v models.Lease
+	if err := FindOne(ctx, db.Collection(LeaseCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func LeaseInsertOne(ctx context.Context, db *mongo.Database, v *models.Lease, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(LeaseCollectionName), v, opts...)
+}
+
+func LeaseFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.Lease, error) {
+	var v models.Lease
+	if err := FindOneAndUpdate(ctx, db.Collection(LeaseCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func LeaseFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.Lease, error) {
+	var v []*models.Lease
+	if err := Find(ctx, db.Collection(LeaseCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func LeaseFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.Lease, *Page, error) {
+	return FindPage[models.Lease](ctx, db.Collection(LeaseCollectionName), filter, cursor, opts)
+}
+
+func LeaseIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(LeaseCollectionName), models, opts...)
+}
+
+func LeaseDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(LeaseCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func LeaseBulkWrite(ctx context.Context, db *mongo.Database, models []mongo.WriteModel, opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error) {
+	return BulkWrite(ctx, db.Collection(LeaseCollectionName), models, opts...)
+}
+
+func LeaseDistinct(ctx context.Context, db *mongo.Database, fieldName string, filter bson.M, opts ...*options.DistinctOptions) (bson.A, error) {
+	return Distinct(ctx, db.Collection(LeaseCollectionName), fieldName, filter, opts...)
+}
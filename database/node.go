@@ -8,6 +8,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
 )
 
 const (
@@ -45,6 +46,10 @@ func NodeFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*o
 	return v, nil
 }
 
+func NodeFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.Node, *Page, error) {
+	return FindPage[models.Node](ctx, db.Collection(NodeCollectionName), filter, cursor, opts)
+}
+
 func NodeIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
 	return IndexesCreateMany(ctx, db.Collection(NodeCollectionName), models, opts...)
 }
@@ -52,3 +57,16 @@ func NodeIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mon
 func NodeCountDocuments(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.CountOptions) (int64, error) {
 	return CountDocuments(ctx, db.Collection(NodeCollectionName), filter, opts...)
 }
+
+func NodeDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(NodeCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NodeBulkWrite(ctx context.Context, db *mongo.Database, models []mongo.WriteModel, opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error) {
+	return BulkWrite(ctx, db.Collection(NodeCollectionName), models, opts...)
+}
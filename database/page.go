@@ -0,0 +1,356 @@
+package database
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+// Page holds the total number of documents matching a filter and the cursors
+// of the pages around the found documents, which are nil at either end.
+type Page struct {
+	Total int64
+	Next  *utils.Cursor
+	Prev  *utils.Cursor
+}
+
+// Pagination returns the pagination of the response with the links to the
+// adjacent pages, built from the request URL with the cursor replaced.
+func (p *Page) Pagination(u *url.URL) *types.Pagination {
+	link := func(c *utils.Cursor) string {
+		if c == nil {
+			return ""
+		}
+
+		query := u.Query()
+		query.Del("skip")
+		query.Set("cursor", c.String())
+
+		return u.Path + "?" + query.Encode()
+	}
+
+	return &types.Pagination{
+		Total: p.Total,
+		Next:  link(p.Next),
+		Prev:  link(p.Prev),
+	}
+}
+
+// FindPage finds the documents after or before the cursor, in the order of the
+// sort of the options with the _id key appended. Without a cursor the skip of
+// the options is applied as is, so the first page stays compatible with the
+// offset based requests.
+func FindPage[T any](ctx context.Context, c *mongo.Collection, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*T, *Page, error) {
+	var sort bson.D
+	if opts.Sort != nil {
+		sort = opts.Sort.(bson.D)
+	}
+
+	sort = utils.CursorSort(sort)
+
+	var limit int64
+	if opts.Limit != nil {
+		limit = *opts.Limit
+	}
+
+	var (
+		query   = filter
+		reverse = cursor != nil && cursor.Reverse
+		find    = options.MergeFindOptions(opts)
+	)
+
+	if cursor != nil {
+		query = bson.M{
+			"$and": bson.A{filter, seekFilter(sort, cursor.Values, reverse)},
+		}
+		find.SetSkip(0)
+	}
+	if reverse {
+		items := make(bson.D, 0, len(sort))
+		for _, e := range sort {
+			items = append(items, bson.E{Key: e.Key, Value: -sortOrder(e.Value)})
+		}
+
+		find.SetSort(items)
+	} else {
+		find.SetSort(sort)
+	}
+	if limit > 0 {
+		find.SetLimit(limit + 1)
+	}
+	var hidden []string
+	if opts.Projection != nil {
+		var projection bson.M
+		projection, hidden = pageProjection(opts.Projection.(bson.M), sort)
+		find.SetProjection(projection)
+	}
+
+	var raws []bson.Raw
+	if err := Find(ctx, c, query, &raws, find); err != nil {
+		return nil, nil, findError(err)
+	}
+
+	more := limit > 0 && int64(len(raws)) > limit
+	if more {
+		raws = raws[:limit]
+	}
+	if reverse {
+		slices.Reverse(raws)
+	}
+
+	items := make([]*T, 0, len(raws))
+	for _, raw := range raws {
+		raw, err := withoutKeys(raw, hidden)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var v T
+		if err := bson.Unmarshal(raw, &v); err != nil {
+			return nil, nil, err
+		}
+
+		items = append(items, &v)
+	}
+
+	total, err := countPage(ctx, c, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := &Page{
+		Total: total,
+	}
+	if len(raws) > 0 {
+		first, last := raws[0], raws[len(raws)-1]
+		if reverse {
+			if more {
+				page.Prev = newCursor(sort, first, true)
+			}
+
+			page.Next = newCursor(sort, last, false)
+		} else {
+			if more {
+				page.Next = newCursor(sort, last, false)
+			}
+			if cursor != nil || (opts.Skip != nil && *opts.Skip > 0) {
+				page.Prev = newCursor(sort, first, true)
+			}
+		}
+	}
+
+	return items, page, nil
+}
+
+// countPage counts the documents matching the filter, using the estimate from
+// the collection metadata for an empty filter.
+func countPage(ctx context.Context, c *mongo.Collection, filter bson.M) (int64, error) {
+	if len(filter) == 0 {
+		return EstimatedDocumentCount(ctx, c)
+	}
+
+	return CountDocuments(ctx, c, filter)
+}
+
+func sortOrder(v interface{}) int {
+	switch v := v.(type) {
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	default:
+		return v.(int)
+	}
+}
+
+func newCursor(sort bson.D, v bson.Raw, reverse bool) *utils.Cursor {
+	c := &utils.Cursor{
+		Keys:    make([]string, 0, len(sort)),
+		Values:  make(bson.A, 0, len(sort)),
+		Reverse: reverse,
+	}
+
+	for _, e := range sort {
+		var value interface{}
+		if rv, err := v.LookupErr(strings.Split(e.Key, ".")...); err == nil {
+			_ = rv.Unmarshal(&value)
+		}
+
+		c.Keys = append(c.Keys, e.Key)
+		c.Values = append(c.Values, value)
+	}
+
+	return c
+}
+
+// seekFilter returns the filter of the documents which come after the values
+// in the order of the sort, or before them when reversed.
+func seekFilter(sort bson.D, values bson.A, reverse bool) bson.M {
+	items := make(bson.A, 0, len(sort))
+	for i, e := range sort {
+		order := sortOrder(e.Value)
+		if reverse {
+			order = -order
+		}
+
+		cond := bson.M{}
+		for j := 0; j < i; j++ {
+			cond[sort[j].Key] = values[j]
+		}
+
+		switch {
+		case order > 0 && values[i] == nil:
+			cond[e.Key] = bson.M{"$ne": nil}
+		case order > 0:
+			cond[e.Key] = bson.M{"$gt": values[i]}
+		case values[i] == nil:
+			continue
+		default:
+			cond[e.Key] = bson.M{"$lt": values[i]}
+		}
+
+		items = append(items, cond)
+	}
+	if len(items) == 0 {
+		return bson.M{
+			"_id": bson.M{"$exists": false},
+		}
+	}
+
+	return bson.M{
+		"$or": items,
+	}
+}
+
+// pageProjection returns the projection with the _id and the sort keys kept,
+// as the cursors are built from their values. The keys which it keeps but the
+// given projection leaves out are returned too, so that they are removed from
+// the found documents.
+func pageProjection(v bson.M, sort bson.D) (items bson.M, hidden []string) {
+	items = make(bson.M, len(v)+len(sort))
+	for key, value := range v {
+		if key != "_id" {
+			items[key] = value
+		}
+	}
+
+	inclusion := false
+	for _, value := range items {
+		if projectionIncludes(value) {
+			inclusion = true
+			break
+		}
+	}
+
+	if value, ok := v["_id"]; ok && !projectionIncludes(value) {
+		hidden = append(hidden, "_id")
+	}
+
+	for _, e := range sort {
+		if e.Key == "_id" {
+			continue
+		}
+
+		_, ok := items[e.Key]
+		if inclusion {
+			if !ok {
+				hidden = append(hidden, e.Key)
+			}
+
+			items[e.Key] = 1
+		} else {
+			if ok {
+				hidden = append(hidden, e.Key)
+			}
+
+			delete(items, e.Key)
+		}
+	}
+
+	return items, hidden
+}
+
+// withoutKeys returns the document with the keys, which may be dotted paths to
+// the fields of the embedded documents, removed.
+func withoutKeys(raw bson.Raw, keys []string) (bson.Raw, error) {
+	if len(keys) == 0 {
+		return raw, nil
+	}
+
+	var v bson.D
+	if err := bson.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		v = removeKey(v, strings.Split(key, "."))
+	}
+
+	return bson.Marshal(v)
+}
+
+func removeKey(v bson.D, path []string) bson.D {
+	for i, e := range v {
+		if e.Key != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return append(v[:i], v[i+1:]...)
+		}
+		if d, ok := e.Value.(bson.D); ok {
+			// An embedded document left empty held only the removed key.
+			if d = removeKey(d, path[1:]); len(d) == 0 {
+				return append(v[:i], v[i+1:]...)
+			}
+
+			v[i].Value = d
+		}
+
+		break
+	}
+
+	return v
+}
+
+// projectionIncludes reports whether a projection value includes its field,
+// which MongoDB allows to be given as any non-zero number or true.
+func projectionIncludes(v interface{}) bool {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case int:
+		return v != 0
+	case int8:
+		return v != 0
+	case int16:
+		return v != 0
+	case int32:
+		return v != 0
+	case int64:
+		return v != 0
+	case uint:
+		return v != 0
+	case uint8:
+		return v != 0
+	case uint16:
+		return v != 0
+	case uint32:
+		return v != 0
+	case uint64:
+		return v != 0
+	case float32:
+		return v != 0
+	case float64:
+		return v != 0
+	default:
+		return false
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
 )
 
 const (
@@ -45,6 +46,23 @@ func PlanFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*o
 	return v, nil
 }
 
+func PlanFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.Plan, *Page, error) {
+	return FindPage[models.Plan](ctx, db.Collection(PlanCollectionName), filter, cursor, opts)
+}
+
 func PlanIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
 	return IndexesCreateMany(ctx, db.Collection(PlanCollectionName), models, opts...)
 }
+
+func PlanDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(PlanCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func PlanBulkWrite(ctx context.Context, db *mongo.Database, models []mongo.WriteModel, opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error) {
+	return BulkWrite(ctx, db.Collection(PlanCollectionName), models, opts...)
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	ProposalDepositCollectionName = "proposal_deposits"
+)
+
+func ProposalDepositFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.ProposalDeposit, error) {
+	var v models.ProposalDeposit
+	if err := FindOne(ctx, db.Collection(ProposalDepositCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func ProposalDepositInsertOne(ctx context.Context, db *mongo.Database, v *models.ProposalDeposit, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(ProposalDepositCollectionName), v, opts...)
+}
+
+func ProposalDepositFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.ProposalDeposit, error) {
+	var v models.ProposalDeposit
+	if err := FindOneAndUpdate(ctx, db.Collection(ProposalDepositCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func ProposalDepositFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.ProposalDeposit, error) {
+	var v []*models.ProposalDeposit
+	if err := Find(ctx, db.Collection(ProposalDepositCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func ProposalDepositFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.ProposalDeposit, *Page, error) {
+	return FindPage[models.ProposalDeposit](ctx, db.Collection(ProposalDepositCollectionName), filter, cursor, opts)
+}
+
+func ProposalDepositIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(ProposalDepositCollectionName), models, opts...)
+}
+
+func ProposalDepositDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(ProposalDepositCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
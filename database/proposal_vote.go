@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	ProposalVoteCollectionName = "proposal_votes"
+)
+
+func ProposalVoteFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.ProposalVote, error) {
+	var v models.ProposalVote
+	if err := FindOne(ctx, db.Collection(ProposalVoteCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func ProposalVoteInsertOne(ctx context.Context, db *mongo.Database, v *models.ProposalVote, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(ProposalVoteCollectionName), v, opts...)
+}
+
+func ProposalVoteFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.ProposalVote, error) {
+	var v models.ProposalVote
+	if err := FindOneAndUpdate(ctx, db.Collection(ProposalVoteCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func ProposalVoteFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.ProposalVote, error) {
+	var v []*models.ProposalVote
+	if err := Find(ctx, db.Collection(ProposalVoteCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func ProposalVoteFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.ProposalVote, *Page, error) {
+	return FindPage[models.ProposalVote](ctx, db.Collection(ProposalVoteCollectionName), filter, cursor, opts)
+}
+
+func ProposalVoteIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(ProposalVoteCollectionName), models, opts...)
+}
+
+func ProposalVoteDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(ProposalVoteCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func ProposalVoteCountDocuments(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.CountOptions) (int64, error) {
+	return CountDocuments(ctx, db.Collection(ProposalVoteCollectionName), filter, opts...)
+}
@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	RedelegationCollectionName = "redelegations"
+)
+
+func RedelegationFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.Redelegation, error) {
+	var v models.Redelegation
+	if err := FindOne(ctx, db.Collection(RedelegationCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func RedelegationInsertOne(ctx context.Context, db *mongo.Database, v *models.Redelegation, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(RedelegationCollectionName), v, opts...)
+}
+
+func RedelegationFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.Redelegation, error) {
+	var v models.Redelegation
+	if err := FindOneAndUpdate(ctx, db.Collection(RedelegationCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func RedelegationFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.Redelegation, error) {
+	var v []*models.Redelegation
+	if err := Find(ctx, db.Collection(RedelegationCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func RedelegationFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.Redelegation, *Page, error) {
+	return FindPage[models.Redelegation](ctx, db.Collection(RedelegationCollectionName), filter, cursor, opts)
+}
+
+func RedelegationIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(RedelegationCollectionName), models, opts...)
+}
+
+func RedelegationUpdateMany(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	return UpdateMany(ctx, db.Collection(RedelegationCollectionName), filter, update, opts...)
+}
+
+func RedelegationDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(RedelegationCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
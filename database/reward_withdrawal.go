@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	RewardWithdrawalCollectionName = "reward_withdrawals"
+)
+
+func RewardWithdrawalFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.RewardWithdrawal, error) {
+	var v models.RewardWithdrawal
+	if err := FindOne(ctx, db.Collection(RewardWithdrawalCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func RewardWithdrawalInsertOne(ctx context.Context, db *mongo.Database, v *models.RewardWithdrawal, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(RewardWithdrawalCollectionName), v, opts...)
+}
+
+func RewardWithdrawalFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.RewardWithdrawal, error) {
+	var v models.RewardWithdrawal
+	if err := FindOneAndUpdate(ctx, db.Collection(RewardWithdrawalCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func RewardWithdrawalFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.RewardWithdrawal, error) {
+	var v []*models.RewardWithdrawal
+	if err := Find(ctx, db.Collection(RewardWithdrawalCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func RewardWithdrawalFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.RewardWithdrawal, *Page, error) {
+	return FindPage[models.RewardWithdrawal](ctx, db.Collection(RewardWithdrawalCollectionName), filter, cursor, opts)
+}
+
+func RewardWithdrawalIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(RewardWithdrawalCollectionName), models, opts...)
+}
+
+func RewardWithdrawalDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(RewardWithdrawalCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
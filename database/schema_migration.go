@@ -0,0 +1,59 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+)
+
+const (
+	SchemaMigrationCollectionName = "schema_migrations"
+)
+
+func SchemaMigrationFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.SchemaMigration, error) {
+	var v models.SchemaMigration
+	if err := FindOne(ctx, db.Collection(SchemaMigrationCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func SchemaMigrationInsertOne(ctx context.Context, db *mongo.Database, v *models.SchemaMigration, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(SchemaMigrationCollectionName), v, opts...)
+}
+
+func SchemaMigrationFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.SchemaMigration, error) {
+	var v models.SchemaMigration
+	if err := FindOneAndUpdate(ctx, db.Collection(SchemaMigrationCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func SchemaMigrationFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.SchemaMigration, error) {
+	var v []*models.SchemaMigration
+	if err := Find(ctx, db.Collection(SchemaMigrationCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func SchemaMigrationIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(SchemaMigrationCollectionName), models, opts...)
+}
+
+func SchemaMigrationDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(SchemaMigrationCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
 )
 
 const (
@@ -45,6 +46,10 @@ func SessionFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ..
 	return v, nil
 }
 
+func SessionFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.Session, *Page, error) {
+	return FindPage[models.Session](ctx, db.Collection(SessionCollectionName), filter, cursor, opts)
+}
+
 func SessionIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
 	return IndexesCreateMany(ctx, db.Collection(SessionCollectionName), models, opts...)
 }
@@ -56,3 +61,16 @@ func SessionCountDocuments(ctx context.Context, db *mongo.Database, filter bson.
 func SessionDistinct(ctx context.Context, db *mongo.Database, fieldName string, filter bson.M, opts ...*options.DistinctOptions) (bson.A, error) {
 	return Distinct(ctx, db.Collection(SessionCollectionName), fieldName, filter, opts...)
 }
+
+func SessionDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(SessionCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func SessionBulkWrite(ctx context.Context, db *mongo.Database, models []mongo.WriteModel, opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error) {
+	return BulkWrite(ctx, db.Collection(SessionCollectionName), models, opts...)
+}
@@ -8,6 +8,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
 )
 
 const (
@@ -45,6 +46,10 @@ func SubscriptionFind(ctx context.Context, db *mongo.Database, filter bson.M, op
 	return v, nil
 }
 
+func SubscriptionFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.Subscription, *Page, error) {
+	return FindPage[models.Subscription](ctx, db.Collection(SubscriptionCollectionName), filter, cursor, opts)
+}
+
 func SubscriptionIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
 	return IndexesCreateMany(ctx, db.Collection(SubscriptionCollectionName), models, opts...)
 }
@@ -52,3 +57,20 @@ func SubscriptionIndexesCreateMany(ctx context.Context, db *mongo.Database, mode
 func SubscriptionCountDocuments(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.CountOptions) (int64, error) {
 	return CountDocuments(ctx, db.Collection(SubscriptionCollectionName), filter, opts...)
 }
+
+func SubscriptionDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(SubscriptionCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func SubscriptionDistinct(ctx context.Context, db *mongo.Database, fieldName string, filter bson.M, opts ...*options.DistinctOptions) (bson.A, error) {
+	return Distinct(ctx, db.Collection(SubscriptionCollectionName), fieldName, filter, opts...)
+}
+
+func SubscriptionBulkWrite(ctx context.Context, db *mongo.Database, models []mongo.WriteModel, opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error) {
+	return BulkWrite(ctx, db.Collection(SubscriptionCollectionName), models, opts...)
+}
@@ -8,6 +8,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
 )
 
 const (
@@ -45,6 +46,23 @@ func SubscriptionAllocationFind(ctx context.Context, db *mongo.Database, filter
 	return v, nil
 }
 
+func SubscriptionAllocationFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.SubscriptionAllocation, *Page, error) {
+	return FindPage[models.SubscriptionAllocation](ctx, db.Collection(SubscriptionAllocationCollectionName), filter, cursor, opts)
+}
+
 func SubscriptionAllocationIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
 	return IndexesCreateMany(ctx, db.Collection(SubscriptionAllocationCollectionName), models, opts...)
 }
+
+func SubscriptionAllocationDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(SubscriptionAllocationCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func SubscriptionAllocationBulkWrite(ctx context.Context, db *mongo.Database, models []mongo.WriteModel, opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error) {
+	return BulkWrite(ctx, db.Collection(SubscriptionAllocationCollectionName), models, opts...)
+}
@@ -48,3 +48,12 @@ func SubscriptionPayoutFind(ctx context.Context, db *mongo.Database, filter bson
 func SubscriptionPayoutIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
 	return IndexesCreateMany(ctx, db.Collection(SubscriptionPayoutCollectionName), models, opts...)
 }
+
+func SubscriptionPayoutDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(SubscriptionPayoutCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -39,3 +39,7 @@ func SyncStatusFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter,
 func SyncStatusIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
 	return IndexesCreateMany(ctx, db.Collection(SyncStatusCollectionName), models, opts...)
 }
+
+func SyncStatusUpdateMany(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	return UpdateMany(ctx, db.Collection(SyncStatusCollectionName), filter, update, opts...)
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	TransferCollectionName = "transfers"
+)
+
+func TransferFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.Transfer, error) {
+	var v models.Transfer
+	if err := FindOne(ctx, db.Collection(TransferCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func TransferInsertOne(ctx context.Context, db *mongo.Database, v *models.Transfer, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(TransferCollectionName), v, opts...)
+}
+
+func TransferFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.Transfer, error) {
+	var v models.Transfer
+	if err := FindOneAndUpdate(ctx, db.Collection(TransferCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func TransferFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.Transfer, error) {
+	var v []*models.Transfer
+	if err := Find(ctx, db.Collection(TransferCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func TransferFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.Transfer, *Page, error) {
+	return FindPage[models.Transfer](ctx, db.Collection(TransferCollectionName), filter, cursor, opts)
+}
+
+func TransferIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(TransferCollectionName), models, opts...)
+}
+
+func TransferDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(TransferCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	TxFailureCollectionName = "tx_failures"
+)
+
+func TxFailureFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.TxFailure, error) {
+	var v models.TxFailure
+	if err := FindOne(ctx, db.Collection(TxFailureCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func TxFailureInsertOne(ctx context.Context, db *mongo.Database, v *models.TxFailure, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(TxFailureCollectionName), v, opts...)
+}
+
+func TxFailureFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.TxFailure, error) {
+	var v models.TxFailure
+	if err := FindOneAndUpdate(ctx, db.Collection(TxFailureCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func TxFailureFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.TxFailure, error) {
+	var v []*models.TxFailure
+	if err := Find(ctx, db.Collection(TxFailureCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func TxFailureFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.TxFailure, *Page, error) {
+	return FindPage[models.TxFailure](ctx, db.Collection(TxFailureCollectionName), filter, cursor, opts)
+}
+
+func TxFailureIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(TxFailureCollectionName), models, opts...)
+}
+
+func TxFailureDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(TxFailureCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
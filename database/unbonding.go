@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	UnbondingCollectionName = "unbondings"
+)
+
+func UnbondingFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.Unbonding, error) {
+	var v models.Unbonding
+	if err := FindOne(ctx, db.Collection(UnbondingCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func UnbondingInsertOne(ctx context.Context, db *mongo.Database, v *models.Unbonding, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(UnbondingCollectionName), v, opts...)
+}
+
+func UnbondingFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.Unbonding, error) {
+	var v models.Unbonding
+	if err := FindOneAndUpdate(ctx, db.Collection(UnbondingCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func UnbondingFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.Unbonding, error) {
+	var v []*models.Unbonding
+	if err := Find(ctx, db.Collection(UnbondingCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func UnbondingFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.Unbonding, *Page, error) {
+	return FindPage[models.Unbonding](ctx, db.Collection(UnbondingCollectionName), filter, cursor, opts)
+}
+
+func UnbondingIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(UnbondingCollectionName), models, opts...)
+}
+
+func UnbondingUpdateMany(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	return UpdateMany(ctx, db.Collection(UnbondingCollectionName), filter, update, opts...)
+}
+
+func UnbondingDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(UnbondingCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
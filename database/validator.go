@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	ValidatorCollectionName = "validators"
+)
+
+func ValidatorFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.Validator, error) {
+	var v models.Validator
+	if err := FindOne(ctx, db.Collection(ValidatorCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func ValidatorInsertOne(ctx context.Context, db *mongo.Database, v *models.Validator, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(ValidatorCollectionName), v, opts...)
+}
+
+func ValidatorFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.Validator, error) {
+	var v models.Validator
+	if err := FindOneAndUpdate(ctx, db.Collection(ValidatorCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func ValidatorFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.Validator, error) {
+	var v []*models.Validator
+	if err := Find(ctx, db.Collection(ValidatorCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func ValidatorFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.Validator, *Page, error) {
+	return FindPage[models.Validator](ctx, db.Collection(ValidatorCollectionName), filter, cursor, opts)
+}
+
+func ValidatorIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(ValidatorCollectionName), models, opts...)
+}
+
+func ValidatorBulkWrite(ctx context.Context, db *mongo.Database, models []mongo.WriteModel, opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error) {
+	return BulkWrite(ctx, db.Collection(ValidatorCollectionName), models, opts...)
+}
+
+func ValidatorDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(ValidatorCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
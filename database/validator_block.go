@@ -0,0 +1,72 @@
+package database
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	ValidatorBlockCollectionName = "validator_blocks"
+)
+
+func ValidatorBlockFindOne(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOneOptions) (*models.ValidatorBlock, error) {
+	var v models.ValidatorBlock
+	if err := FindOne(ctx, db.Collection(ValidatorBlockCollectionName), filter, &v, opts...); err != nil {
+		return nil, findOneError(err)
+	}
+
+	return &v, nil
+}
+
+func ValidatorBlockInsertOne(ctx context.Context, db *mongo.Database, v *models.ValidatorBlock, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+	return InsertOne(ctx, db.Collection(ValidatorBlockCollectionName), v, opts...)
+}
+
+func ValidatorBlockFindOneAndUpdate(ctx context.Context, db *mongo.Database, filter, update bson.M, opts ...*options.FindOneAndUpdateOptions) (*models.ValidatorBlock, error) {
+	var v models.ValidatorBlock
+	if err := FindOneAndUpdate(ctx, db.Collection(ValidatorBlockCollectionName), filter, update, &v, opts...); err != nil {
+		return nil, findOneAndUpdateError(err)
+	}
+
+	return &v, nil
+}
+
+func ValidatorBlockFind(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.FindOptions) ([]*models.ValidatorBlock, error) {
+	var v []*models.ValidatorBlock
+	if err := Find(ctx, db.Collection(ValidatorBlockCollectionName), filter, &v, opts...); err != nil {
+		return nil, findError(err)
+	}
+
+	return v, nil
+}
+
+func ValidatorBlockFindPage(ctx context.Context, db *mongo.Database, filter bson.M, cursor *utils.Cursor, opts *options.FindOptions) ([]*models.ValidatorBlock, *Page, error) {
+	return FindPage[models.ValidatorBlock](ctx, db.Collection(ValidatorBlockCollectionName), filter, cursor, opts)
+}
+
+func ValidatorBlockIndexesCreateMany(ctx context.Context, db *mongo.Database, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error) {
+	return IndexesCreateMany(ctx, db.Collection(ValidatorBlockCollectionName), models, opts...)
+}
+
+func ValidatorBlockBulkWrite(ctx context.Context, db *mongo.Database, models []mongo.WriteModel, opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error) {
+	return BulkWrite(ctx, db.Collection(ValidatorBlockCollectionName), models, opts...)
+}
+
+func ValidatorBlockCountDocuments(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.CountOptions) (int64, error) {
+	return CountDocuments(ctx, db.Collection(ValidatorBlockCollectionName), filter, opts...)
+}
+
+func ValidatorBlockDeleteMany(ctx context.Context, db *mongo.Database, filter bson.M, opts ...*options.DeleteOptions) error {
+	_, err := DeleteMany(ctx, db.Collection(ValidatorBlockCollectionName), filter, opts...)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
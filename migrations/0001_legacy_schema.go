@@ -0,0 +1,683 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+)
+
+// The legacy schema migration moves a database written by the v1 explorer to
+// the v2 schema. It only applies to a database which was indexed up to the
+// block before the v2 upgrade, any other database is left untouched and the
+// migration is recorded as skipped.
+
+const (
+	legacyHeight = 12_310_005
+)
+
+var (
+	legacyTimestamp     = time.Date(2023, 8, 18, 12, 10, 36, 572027592, time.UTC)
+	legacyTimestampDate = time.Date(2023, 8, 18, 0, 0, 0, 0, time.UTC)
+
+	legacyCollectionNames = []string{
+		"deposits",
+		"events",
+		"nodes",
+		"node_statistics",
+		"plans",
+		"providers",
+		"sessions",
+		"subscriptions",
+		"subscription_quotas",
+	}
+
+	legacyStatuses = map[string]string{
+		"STATUS_ACTIVE":           "active",
+		"STATUS_INACTIVE_PENDING": "inactive_pending",
+		"STATUS_INACTIVE":         "inactive",
+	}
+)
+
+func init() {
+	register(&Migration{
+		Version: 1,
+		Name:    "legacy_schema",
+		Up:      upLegacySchema,
+		Down:    nil,
+	})
+}
+
+func upLegacySchema(ctx context.Context, db *mongo.Database) error {
+	opts := options.FindOne().
+		SetSort(bson.D{bson.E{Key: "height", Value: -1}})
+
+	dBlock, err := database.BlockFindOne(ctx, db, bson.M{}, opts)
+	if err != nil {
+		return err
+	}
+	if dBlock == nil || dBlock.Height != legacyHeight-1 {
+		log.Println("LegacySchema", "Skipping", "the latest block is not at height", legacyHeight-1)
+		return ErrSkipped
+	}
+
+	if err := dropLegacyIndexes(ctx, db); err != nil {
+		return err
+	}
+
+	err = renameCollection(ctx, db, "subscription_quotas", database.SubscriptionAllocationCollectionName)
+	if err != nil {
+		return err
+	}
+
+	steps := []func(context.Context, *mongo.Database) error{
+		upLegacyDeposits,
+		upLegacyEvents,
+		upLegacyNodes,
+		upLegacyPlans,
+		upLegacyProviders,
+		upLegacySessions,
+		upLegacySubscriptions,
+		upLegacyNodeSubscriptions,
+		upLegacyPlanSubscriptions,
+		upLegacySubscriptionAllocations,
+		upLegacyNodeStatistics,
+	}
+
+	for _, step := range steps {
+		if err := step(ctx, db); err != nil {
+			return err
+		}
+	}
+
+	return dropLegacyIndexes(ctx, db)
+}
+
+func dropLegacyIndexes(ctx context.Context, db *mongo.Database) error {
+	for _, name := range legacyCollectionNames {
+		err := database.IndexesDropAll(ctx, db.Collection(name))
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func renameCollection(ctx context.Context, db *mongo.Database, from, to string) error {
+	cmd := bson.D{
+		bson.E{Key: "renameCollection", Value: db.Name() + "." + from},
+		bson.E{Key: "to", Value: db.Name() + "." + to},
+		bson.E{Key: "dropTarget", Value: true},
+	}
+
+	err := db.Client().Database("admin").RunCommand(ctx, cmd).Err()
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func renameFields(ctx context.Context, c *mongo.Collection, fields bson.M) error {
+	_, err := database.UpdateMany(ctx, c, bson.M{}, bson.M{"$rename": fields})
+	return err
+}
+
+func unsetFields(ctx context.Context, c *mongo.Collection, fields ...string) error {
+	unset := bson.M{}
+	for _, field := range fields {
+		unset[field] = 1
+	}
+
+	_, err := database.UpdateMany(ctx, c, bson.M{}, bson.M{"$unset": unset})
+	return err
+}
+
+func setField(ctx context.Context, c *mongo.Collection, field string, from, to interface{}) error {
+	_, err := database.UpdateMany(ctx, c, bson.M{field: from}, bson.M{"$set": bson.M{field: to}})
+	return err
+}
+
+func renameStatuses(ctx context.Context, c *mongo.Collection) error {
+	for from, to := range legacyStatuses {
+		if err := setField(ctx, c, "status", from, to); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func bulkWrite(ctx context.Context, c *mongo.Collection, models []mongo.WriteModel) error {
+	if len(models) == 0 {
+		return nil
+	}
+
+	_, err := database.BulkWrite(ctx, c, models, options.BulkWrite().SetOrdered(false))
+	return err
+}
+
+func findAll(ctx context.Context, c *mongo.Collection, filter bson.M, opts ...*options.FindOptions) (items []bson.M, err error) {
+	if err := database.Find(ctx, c, filter, &items, opts...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func findOne(ctx context.Context, c *mongo.Collection, filter bson.M) (bson.M, error) {
+	var v bson.M
+	if err := database.FindOne(ctx, c, filter, &v); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// toInt converts the numbers, which the v1 explorer stored either as strings
+// or as numbers, to an integer. A missing value is taken as zero.
+func toInt(v interface{}) (sdk.Int, error) {
+	switch v := v.(type) {
+	case nil:
+		return sdk.ZeroInt(), nil
+	case string:
+		i, ok := sdk.NewIntFromString(v)
+		if !ok {
+			return sdk.Int{}, fmt.Errorf("invalid integer %s", v)
+		}
+
+		return i, nil
+	case int32:
+		return sdk.NewInt(int64(v)), nil
+	case int64:
+		return sdk.NewInt(v), nil
+	case float64:
+		return sdk.NewInt(int64(v)), nil
+	default:
+		return sdk.Int{}, fmt.Errorf("invalid integer type %T", v)
+	}
+}
+
+// coinAmount returns the amount of a coin document, which is zero for a
+// missing coin.
+func coinAmount(v interface{}) (sdk.Int, error) {
+	coin, ok := v.(bson.M)
+	if !ok {
+		return sdk.ZeroInt(), nil
+	}
+
+	return toInt(coin["amount"])
+}
+
+func newStatusEvent(kind, key string, value interface{}) mongo.WriteModel {
+	return mongo.NewInsertOneModel().SetDocument(bson.M{
+		"type":      kind,
+		"height":    legacyHeight,
+		"timestamp": legacyTimestamp,
+		"tx_hash":   "",
+		key:         value,
+		"status":    "inactive",
+	})
+}
+
+func newNodeStatisticInc(addr, field string) mongo.WriteModel {
+	return mongo.NewUpdateOneModel().
+		SetFilter(bson.M{"address": addr, "timestamp": legacyTimestampDate}).
+		SetUpdate(bson.M{"$inc": bson.M{field: 1}}).
+		SetUpsert(true)
+}
+
+func upLegacyDeposits(ctx context.Context, db *mongo.Database) error {
+	return renameFields(ctx, db.Collection("deposits"), bson.M{"address": "addr"})
+}
+
+func upLegacyEvents(ctx context.Context, db *mongo.Database) error {
+	c := db.Collection("events")
+
+	err := renameFields(ctx, c, bson.M{
+		"acc_address":  "acc_addr",
+		"allocated":    "granted_bytes",
+		"consumed":     "utilised_bytes",
+		"node_address": "node_addr",
+		"price":        "gigabyte_prices",
+		"prov_address": "prov_addr",
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := unsetFields(ctx, c, "free"); err != nil {
+		return err
+	}
+
+	types := map[string]string{
+		"Plan.AddNode":                    "Plan.LinkNode",
+		"Plan.RemoveNode":                 "Plan.UnlinkNode",
+		"SubscriptionQuota.UpdateDetails": "SubscriptionAllocation.UpdateDetails",
+	}
+
+	for from, to := range types {
+		if err := setField(ctx, c, "type", from, to); err != nil {
+			return err
+		}
+	}
+
+	return renameStatuses(ctx, c)
+}
+
+func upLegacyNodes(ctx context.Context, db *mongo.Database) error {
+	c := db.Collection("nodes")
+
+	err := renameFields(ctx, c, bson.M{
+		"address":      "addr",
+		"bandwidth":    "internet_speed",
+		"handshake":    "handshake_dns",
+		"price":        "gigabyte_prices",
+		"reach_status": "health",
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := unsetFields(ctx, c, "provider"); err != nil {
+		return err
+	}
+	if err := renameStatuses(ctx, c); err != nil {
+		return err
+	}
+
+	for _, field := range []string{"internet_speed", "handshake_dns", "location", "qos", "health"} {
+		if err := setField(ctx, c, field, nil, bson.M{}); err != nil {
+			return err
+		}
+	}
+
+	items, err := findAll(ctx, c, bson.M{})
+	if err != nil {
+		return err
+	}
+
+	var models []mongo.WriteModel
+	for _, item := range items {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": item["_id"]}).
+			SetUpdate(bson.M{"$set": bson.M{"hourly_prices": item["gigabyte_prices"]}}))
+	}
+
+	return bulkWrite(ctx, c, models)
+}
+
+func upLegacyPlans(ctx context.Context, db *mongo.Database) error {
+	c := db.Collection("plans")
+
+	err := renameFields(ctx, c, bson.M{
+		"provider_address": "prov_addr",
+		"price":            "prices",
+		"bytes":            "gigabytes",
+		"validity":         "duration",
+		"node_addresses":   "node_addrs",
+		"add_height":       "create_height",
+		"add_timestamp":    "create_timestamp",
+		"add_tx_hash":      "create_tx_hash",
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := renameStatuses(ctx, c); err != nil {
+		return err
+	}
+
+	items, err := findAll(ctx, c, bson.M{})
+	if err != nil {
+		return err
+	}
+
+	// The plans held the bytes, which are rounded up to gigabytes.
+	var models []mongo.WriteModel
+	for _, item := range items {
+		bytes, err := toInt(item["gigabytes"])
+		if err != nil {
+			return err
+		}
+
+		gigabytes := int64(math.Ceil(float64(bytes.Int64()) / 1e9))
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": item["_id"]}).
+			SetUpdate(bson.M{"$set": bson.M{"gigabytes": gigabytes}}))
+	}
+
+	return bulkWrite(ctx, c, models)
+}
+
+func upLegacyProviders(ctx context.Context, db *mongo.Database) error {
+	c := db.Collection("providers")
+
+	if err := renameFields(ctx, c, bson.M{"address": "addr"}); err != nil {
+		return err
+	}
+
+	items, err := findAll(ctx, c, bson.M{})
+	if err != nil {
+		return err
+	}
+
+	var providerModels, eventModels []mongo.WriteModel
+	for _, item := range items {
+		providerModels = append(providerModels, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": item["_id"]}).
+			SetUpdate(bson.M{
+				"$set": bson.M{
+					"status":           "active",
+					"status_height":    legacyHeight,
+					"status_timestamp": legacyTimestamp,
+					"status_tx_hash":   "",
+				},
+			}))
+		eventModels = append(eventModels, mongo.NewInsertOneModel().SetDocument(bson.M{
+			"type":        "Provider.UpdateDetails",
+			"height":      legacyHeight,
+			"timestamp":   legacyTimestamp,
+			"tx_hash":     "",
+			"prov_addr":   item["addr"],
+			"name":        item["name"],
+			"identity":    item["identity"],
+			"website":     item["website"],
+			"description": item["description"],
+			"status":      "active",
+		}))
+	}
+
+	if err := bulkWrite(ctx, c, providerModels); err != nil {
+		return err
+	}
+
+	return bulkWrite(ctx, db.Collection("events"), eventModels)
+}
+
+// upLegacySessions ends every session which was not inactive at the upgrade,
+// since the upgrade removed them from the chain state.
+func upLegacySessions(ctx context.Context, db *mongo.Database) error {
+	c := db.Collection("sessions")
+
+	err := renameFields(ctx, c, bson.M{
+		"subscription": "subscription_id",
+		"address":      "acc_addr",
+		"node":         "node_addr",
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := renameStatuses(ctx, c); err != nil {
+		return err
+	}
+
+	items, err := findAll(ctx, c, bson.M{"status": bson.M{"$ne": "inactive"}})
+	if err != nil {
+		return err
+	}
+
+	var sessionModels, eventModels, statisticModels []mongo.WriteModel
+	for _, item := range items {
+		sessionModels = append(sessionModels, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": item["_id"]}).
+			SetUpdate(bson.M{
+				"$set": bson.M{
+					"end_height":       legacyHeight,
+					"end_timestamp":    legacyTimestamp,
+					"status":           "inactive",
+					"status_height":    legacyHeight,
+					"status_timestamp": legacyTimestamp,
+				},
+			}))
+		eventModels = append(eventModels, newStatusEvent("Session.UpdateStatus", "session_id", item["id"]))
+		statisticModels = append(statisticModels, newNodeStatisticInc(fmt.Sprint(item["node_addr"]), "session_end_count"))
+	}
+
+	if err := bulkWrite(ctx, c, sessionModels); err != nil {
+		return err
+	}
+	if err := bulkWrite(ctx, db.Collection("events"), eventModels); err != nil {
+		return err
+	}
+
+	return bulkWrite(ctx, db.Collection("node_statistics"), statisticModels)
+}
+
+func upLegacySubscriptions(ctx context.Context, db *mongo.Database) error {
+	c := db.Collection("subscriptions")
+
+	err := renameFields(ctx, c, bson.M{
+		"owner":  "acc_addr",
+		"node":   "node_addr",
+		"plan":   "plan_id",
+		"expiry": "inactive_at",
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := unsetFields(ctx, c, "free"); err != nil {
+		return err
+	}
+	if err := renameStatuses(ctx, c); err != nil {
+		return err
+	}
+
+	items, err := findAll(ctx, c, bson.M{"plan_id": bson.M{"$ne": 0}})
+	if err != nil {
+		return err
+	}
+
+	// The plan subscriptions held the denom, which is replaced by the price of
+	// the plan in that denom.
+	var models []mongo.WriteModel
+	for _, item := range items {
+		plan, err := findOne(ctx, db.Collection("plans"), bson.M{"id": item["plan_id"]})
+		if err != nil {
+			return err
+		}
+		if plan == nil {
+			continue
+		}
+
+		prices, _ := plan["prices"].(bson.A)
+		for _, v := range prices {
+			price, ok := v.(bson.M)
+			if !ok || price["denom"] != item["denom"] {
+				continue
+			}
+
+			models = append(models, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"_id": item["_id"]}).
+				SetUpdate(bson.M{"$set": bson.M{"price": price}}))
+			break
+		}
+	}
+
+	if err := bulkWrite(ctx, c, models); err != nil {
+		return err
+	}
+
+	return unsetFields(ctx, c, "denom")
+}
+
+// upLegacyNodeSubscriptions ends every node subscription which was not inactive
+// at the upgrade and refunds the deposit left after the session payments.
+func upLegacyNodeSubscriptions(ctx context.Context, db *mongo.Database) error {
+	c := db.Collection("subscriptions")
+
+	items, err := findAll(ctx, c, bson.M{"plan_id": 0, "status": bson.M{"$ne": "inactive"}})
+	if err != nil {
+		return err
+	}
+
+	var subscriptionModels, eventModels, statisticModels []mongo.WriteModel
+	for _, item := range items {
+		sessions, err := findAll(ctx, db.Collection("sessions"), bson.M{"subscription_id": item["id"]})
+		if err != nil {
+			return err
+		}
+
+		total := sdk.ZeroInt()
+		for _, session := range sessions {
+			payment, err := coinAmount(session["payment"])
+			if err != nil {
+				return err
+			}
+
+			reward, err := coinAmount(session["staking_reward"])
+			if err != nil {
+				return err
+			}
+
+			total = total.Add(payment).Add(reward)
+		}
+
+		deposit, _ := item["deposit"].(bson.M)
+		amount, err := coinAmount(deposit)
+		if err != nil {
+			return err
+		}
+
+		refund := bson.M{
+			"denom":  deposit["denom"],
+			"amount": amount.Sub(total).String(),
+		}
+
+		subscriptionModels = append(subscriptionModels, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": item["_id"]}).
+			SetUpdate(bson.M{
+				"$set": bson.M{
+					"end_height":       legacyHeight,
+					"end_timestamp":    legacyTimestamp,
+					"refund":           refund,
+					"status":           "inactive",
+					"status_height":    legacyHeight,
+					"status_timestamp": legacyTimestamp,
+				},
+			}))
+		eventModels = append(eventModels, newStatusEvent("Subscription.UpdateStatus", "subscription_id", item["id"]))
+		statisticModels = append(statisticModels, newNodeStatisticInc(fmt.Sprint(item["node_addr"]), "subscription_end_count"))
+	}
+
+	if err := bulkWrite(ctx, c, subscriptionModels); err != nil {
+		return err
+	}
+	if err := bulkWrite(ctx, db.Collection("events"), eventModels); err != nil {
+		return err
+	}
+
+	return bulkWrite(ctx, db.Collection("node_statistics"), statisticModels)
+}
+
+// upLegacyPlanSubscriptions grants the bytes of a plan which were not allocated
+// to anyone to the owner of the subscription.
+func upLegacyPlanSubscriptions(ctx context.Context, db *mongo.Database) error {
+	c := db.Collection(database.SubscriptionAllocationCollectionName)
+
+	items, err := findAll(ctx, db.Collection("subscriptions"), bson.M{"plan_id": bson.M{"$ne": 0}})
+	if err != nil {
+		return err
+	}
+
+	var allocationModels, eventModels []mongo.WriteModel
+	for _, item := range items {
+		plan, err := findOne(ctx, db.Collection("plans"), bson.M{"id": item["plan_id"]})
+		if err != nil {
+			return err
+		}
+		if plan == nil {
+			continue
+		}
+
+		allocations, err := findAll(ctx, c, bson.M{"id": item["id"]})
+		if err != nil {
+			return err
+		}
+
+		var (
+			total = sdk.ZeroInt()
+			owner bson.M
+		)
+
+		for _, allocation := range allocations {
+			allocated, err := toInt(allocation["allocated"])
+			if err != nil {
+				return err
+			}
+
+			total = total.Add(allocated)
+			if allocation["address"] == item["acc_addr"] {
+				owner = allocation
+			}
+		}
+		if owner == nil {
+			continue
+		}
+
+		gigabytes, err := toInt(plan["gigabytes"])
+		if err != nil {
+			return err
+		}
+
+		allocated, err := toInt(owner["allocated"])
+		if err != nil {
+			return err
+		}
+
+		grantedBytes := allocated.Add(gigabytes.MulRaw(1e9).Sub(total)).String()
+		allocationModels = append(allocationModels, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"id": item["id"], "address": item["acc_addr"]}).
+			SetUpdate(bson.M{"$set": bson.M{"allocated": grantedBytes}}))
+		eventModels = append(eventModels, mongo.NewInsertOneModel().SetDocument(bson.M{
+			"type":            "SubscriptionAllocation.UpdateDetails",
+			"height":          legacyHeight,
+			"timestamp":       legacyTimestamp,
+			"tx_hash":         "",
+			"subscription_id": owner["id"],
+			"acc_addr":        owner["address"],
+			"granted_bytes":   grantedBytes,
+			"utilised_bytes":  owner["consumed"],
+		}))
+	}
+
+	if err := bulkWrite(ctx, c, allocationModels); err != nil {
+		return err
+	}
+
+	return bulkWrite(ctx, db.Collection("events"), eventModels)
+}
+
+func upLegacySubscriptionAllocations(ctx context.Context, db *mongo.Database) error {
+	return renameFields(ctx, db.Collection(database.SubscriptionAllocationCollectionName), bson.M{
+		"address":   "acc_addr",
+		"allocated": "granted_bytes",
+		"consumed":  "utilised_bytes",
+	})
+}
+
+func upLegacyNodeStatistics(ctx context.Context, db *mongo.Database) error {
+	c := db.Collection("node_statistics")
+
+	if _, err := database.DeleteMany(ctx, c, bson.M{"address": ""}); err != nil {
+		return err
+	}
+
+	return renameFields(ctx, c, bson.M{"address": "addr"})
+}
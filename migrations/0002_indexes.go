@@ -0,0 +1,329 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+)
+
+// baselineIndexes are the indexes which the commands created on start before
+// the migrations were introduced, so a database indexed by them already has
+// them. The migration creates them on a new database but leaves them in place
+// when it is reverted.
+var baselineIndexes = map[string][]mongo.IndexModel{
+	database.AccountCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "addr", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+	},
+	database.BlockCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "height", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+	},
+	database.DelegationCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "acc_addr", Value: 1},
+				bson.E{Key: "val_addr", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "val_addr", Value: 1},
+			},
+		},
+	},
+	database.DepositCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "addr", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+	},
+	database.EventCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "type", Value: 1},
+				bson.E{Key: "timestamp", Value: -1},
+			},
+		},
+	},
+	database.NodeCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "addr", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "status", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "status", Value: 1},
+				bson.E{Key: "register_height", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "status", Value: 1},
+				bson.E{Key: "remote_url", Value: 1},
+			},
+		},
+	},
+	database.NodeStatisticCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "addr", Value: 1},
+				bson.E{Key: "timeframe", Value: 1},
+				bson.E{Key: "timestamp", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+	},
+	database.PlanCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "id", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+	},
+	database.PriceCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "denom", Value: 1},
+				bson.E{Key: "quote", Value: 1},
+				bson.E{Key: "timeframe", Value: 1},
+				bson.E{Key: "timestamp", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+	},
+	database.ProviderCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "addr", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+	},
+	database.RedelegationCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "acc_addr", Value: 1},
+				bson.E{Key: "src_val_addr", Value: 1},
+				bson.E{Key: "dst_val_addr", Value: 1},
+				bson.E{Key: "status", Value: 1},
+			},
+		},
+	},
+	database.RewardWithdrawalCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "acc_addr", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+		},
+	},
+	database.SessionCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "id", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "status", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "status", Value: 1},
+				bson.E{Key: "acc_addr", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "status", Value: 1},
+				bson.E{Key: "node_addr", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "node_addr", Value: 1},
+				bson.E{Key: "status", Value: 1},
+				bson.E{Key: "acc_addr", Value: 1},
+			},
+		},
+	},
+	database.SubscriptionCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "id", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+	},
+	database.SubscriptionAllocationCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "id", Value: 1},
+				bson.E{Key: "acc_addr", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+	},
+	database.SyncStatusCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "app_name", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+	},
+	database.TransferCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "from_addr", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "to_addr", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+		},
+	},
+	database.TxCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "height", Value: 1},
+				bson.E{Key: "result.code", Value: 1},
+			},
+			Options: options.Index().
+				SetPartialFilterExpression(
+					bson.M{
+						"result.code": 0,
+					},
+				),
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "height", Value: 1},
+			},
+			Options: options.Index().
+				SetPartialFilterExpression(
+					bson.M{
+						"result.code": bson.M{
+							"$gt": 0,
+						},
+					},
+				),
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "signers", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "msg_types", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+		},
+	},
+	database.TxFailureCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "tx_hash", Value: 1},
+				bson.E{Key: "msg_index", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "acc_addr", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "node_addr", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+		},
+	},
+	database.UnbondingCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "acc_addr", Value: 1},
+				bson.E{Key: "val_addr", Value: 1},
+				bson.E{Key: "status", Value: 1},
+			},
+		},
+	},
+}
+
+// indexes0002 is the index of the applied migrations, which is the only one
+// the migration introduces.
+var indexes0002 = map[string][]mongo.IndexModel{
+	database.SchemaMigrationCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "version", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+	},
+}
+
+func init() {
+	register(&Migration{
+		Version: 2,
+		Name:    "indexes",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			if err := createIndexModels(ctx, db, baselineIndexes); err != nil {
+				return err
+			}
+
+			return createIndexModels(ctx, db, indexes0002)
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return dropIndexModels(ctx, db, indexes0002)
+		},
+	})
+}
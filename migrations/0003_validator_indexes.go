@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+)
+
+// indexes0003 are the indexes of the validator collections.
+var indexes0003 = map[string][]mongo.IndexModel{
+	database.ValidatorCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "cons_addr", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "oper_addr", Value: 1},
+			},
+		},
+	},
+	database.ValidatorBlockCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "cons_addr", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "cons_addr", Value: 1},
+				bson.E{Key: "missed", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "cons_addr", Value: 1},
+				bson.E{Key: "proposed", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "height", Value: 1},
+				bson.E{Key: "missed", Value: 1},
+			},
+		},
+	},
+}
+
+func init() {
+	register(&Migration{
+		Version: 3,
+		Name:    "validator_indexes",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			return createIndexModels(ctx, db, indexes0003)
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return dropIndexModels(ctx, db, indexes0003)
+		},
+	})
+}
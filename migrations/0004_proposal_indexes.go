@@ -0,0 +1,73 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+)
+
+// indexes0004 are the indexes of the proposal collections.
+var indexes0004 = map[string][]mongo.IndexModel{
+	database.ProposalCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "id", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "status", Value: 1},
+				bson.E{Key: "id", Value: -1},
+			},
+		},
+	},
+	database.ProposalDepositCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "proposal_id", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "depositor", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+		},
+	},
+	database.ProposalVoteCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "proposal_id", Value: 1},
+				bson.E{Key: "voter", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "voter", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+		},
+	},
+}
+
+func init() {
+	register(&Migration{
+		Version: 4,
+		Name:    "proposal_indexes",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			return createIndexModels(ctx, db, indexes0004)
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return dropIndexModels(ctx, db, indexes0004)
+		},
+	})
+}
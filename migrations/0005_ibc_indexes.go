@@ -0,0 +1,79 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+)
+
+// indexes0005 are the indexes of the IBC collections.
+var indexes0005 = map[string][]mongo.IndexModel{
+	database.DenomTraceCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "hash", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+	},
+	database.IBCTransferCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "src_port", Value: 1},
+				bson.E{Key: "src_channel", Value: 1},
+				bson.E{Key: "sequence", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(
+					bson.M{
+						"direction": "outgoing",
+					},
+				),
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "dst_port", Value: 1},
+				bson.E{Key: "dst_channel", Value: 1},
+				bson.E{Key: "sequence", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(
+					bson.M{
+						"direction": "incoming",
+					},
+				),
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "sender", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "receiver", Value: 1},
+				bson.E{Key: "height", Value: -1},
+			},
+		},
+	},
+}
+
+func init() {
+	register(&Migration{
+		Version: 5,
+		Name:    "ibc_indexes",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			return createIndexModels(ctx, db, indexes0005)
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return dropIndexModels(ctx, db, indexes0005)
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+)
+
+// indexes0006 is the index of the events by transaction hash.
+var indexes0006 = map[string][]mongo.IndexModel{
+	database.EventCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "tx_hash", Value: 1},
+			},
+		},
+	},
+}
+
+func init() {
+	register(&Migration{
+		Version: 6,
+		Name:    "event_tx_hash_index",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			return createIndexModels(ctx, db, indexes0006)
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return dropIndexModels(ctx, db, indexes0006)
+		},
+	})
+}
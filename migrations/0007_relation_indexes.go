@@ -0,0 +1,62 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+)
+
+// indexes0007 are the indexes of the sessions and the subscriptions by their
+// relations.
+var indexes0007 = map[string][]mongo.IndexModel{
+	database.SessionCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "acc_addr", Value: 1},
+				bson.E{Key: "id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "subscription_id", Value: 1},
+				bson.E{Key: "id", Value: -1},
+			},
+		},
+	},
+	database.SubscriptionCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "acc_addr", Value: 1},
+				bson.E{Key: "id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "node_addr", Value: 1},
+				bson.E{Key: "id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "plan_id", Value: 1},
+				bson.E{Key: "id", Value: -1},
+			},
+		},
+	},
+}
+
+func init() {
+	register(&Migration{
+		Version: 7,
+		Name:    "relation_indexes",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			return createIndexModels(ctx, db, indexes0007)
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return dropIndexModels(ctx, db, indexes0007)
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+)
+
+// indexes0008 is the index of the events by height.
+var indexes0008 = map[string][]mongo.IndexModel{
+	database.EventCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "height", Value: 1},
+			},
+		},
+	},
+}
+
+func init() {
+	register(&Migration{
+		Version: 8,
+		Name:    "event_height_index",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			return createIndexModels(ctx, db, indexes0008)
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return dropIndexModels(ctx, db, indexes0008)
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+)
+
+// indexes0009 are the indexes of the leases of the hub v3 upgrade.
+var indexes0009 = map[string][]mongo.IndexModel{
+	database.LeaseCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "id", Value: 1},
+			},
+			Options: options.Index().
+				SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "node_addr", Value: 1},
+				bson.E{Key: "id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "prov_addr", Value: 1},
+				bson.E{Key: "id", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				bson.E{Key: "status", Value: 1},
+			},
+		},
+	},
+}
+
+func init() {
+	register(&Migration{
+		Version: 9,
+		Name:    "lease_indexes",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			return createIndexModels(ctx, db, indexes0009)
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return dropIndexModels(ctx, db, indexes0009)
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+)
+
+// indexes0010 is the index of the transactions by hash.
+var indexes0010 = map[string][]mongo.IndexModel{
+	database.TxCollectionName: {
+		{
+			Keys: bson.D{
+				bson.E{Key: "hash", Value: 1},
+			},
+		},
+	},
+}
+
+func init() {
+	register(&Migration{
+		Version: 10,
+		Name:    "tx_hash_index",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			return createIndexModels(ctx, db, indexes0010)
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			return dropIndexModels(ctx, db, indexes0010)
+		},
+	})
+}
@@ -0,0 +1,125 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+)
+
+// Indexes is the current declaration of the indexes of every collection. The
+// commands create the indexes of the collections they use from it on start.
+// It is merged from the indexes of the migrations, which hold their own copy
+// of the ones they introduce so that a later change does not change what an
+// applied migration does. A new index is declared by the migration adding it.
+var Indexes = mergeIndexes(
+	baselineIndexes,
+	indexes0002,
+	indexes0003,
+	indexes0004,
+	indexes0005,
+	indexes0006,
+	indexes0007,
+	indexes0008,
+	indexes0009,
+	indexes0010,
+)
+
+// mergeIndexes returns the indexes of every collection of the given ones, in
+// the order they are given.
+func mergeIndexes(items ...map[string][]mongo.IndexModel) map[string][]mongo.IndexModel {
+	indexes := make(map[string][]mongo.IndexModel)
+	for _, item := range items {
+		for name, models := range item {
+			indexes[name] = append(indexes[name], models...)
+		}
+	}
+
+	return indexes
+}
+
+// collectionNames returns the collections of the indexes, sorted so that the
+// indexes are always created in the same order.
+func collectionNames(indexes map[string][]mongo.IndexModel) []string {
+	items := make([]string, 0, len(indexes))
+	for name := range indexes {
+		items = append(items, name)
+	}
+
+	sort.Strings(items)
+	return items
+}
+
+// CreateIndexes creates the declared indexes of the collections, or of every
+// collection when none is given.
+func CreateIndexes(ctx context.Context, db *mongo.Database, names ...string) error {
+	if len(names) == 0 {
+		names = collectionNames(Indexes)
+	}
+
+	for _, name := range names {
+		if _, ok := Indexes[name]; !ok {
+			return fmt.Errorf("indexes of collection %s are not declared", name)
+		}
+	}
+
+	indexes := make(map[string][]mongo.IndexModel, len(names))
+	for _, name := range names {
+		indexes[name] = Indexes[name]
+	}
+
+	return createIndexModels(ctx, db, indexes)
+}
+
+// createIndexModels creates the indexes, keyed by their collection.
+func createIndexModels(ctx context.Context, db *mongo.Database, indexes map[string][]mongo.IndexModel) error {
+	for _, name := range collectionNames(indexes) {
+		if _, err := database.IndexesCreateMany(ctx, db.Collection(name), indexes[name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// indexName returns the name the server gives to an index without one.
+func indexName(keys bson.D) string {
+	items := make([]string, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, fmt.Sprintf("%s_%v", key.Key, key.Value))
+	}
+
+	return strings.Join(items, "_")
+}
+
+// dropIndexModels drops the indexes, keyed by their collection. The indexes
+// which do not exist are skipped.
+func dropIndexModels(ctx context.Context, db *mongo.Database, indexes map[string][]mongo.IndexModel) error {
+	for _, name := range collectionNames(indexes) {
+		for _, index := range indexes[name] {
+			err := database.IndexesDropOne(ctx, db.Collection(name), indexName(index.Keys.(bson.D)))
+			if err != nil && !isNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isNotFound reports whether the error is either IndexNotFound or
+// NamespaceNotFound, the latter being returned for a missing collection.
+func isNotFound(err error) bool {
+	var e mongo.CommandError
+	if errors.As(err, &e) {
+		return e.Code == 26 || e.Code == 27
+	}
+
+	return false
+}
@@ -0,0 +1,178 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+)
+
+// ErrIrreversible is returned by the down step of a migration which cannot be
+// reverted.
+var ErrIrreversible = errors.New("migration is irreversible")
+
+// ErrSkipped is returned by the up step of a migration which does not apply to
+// the database. The migration is recorded as skipped, and reverting it only
+// removes the record.
+var ErrSkipped = errors.New("migration is skipped")
+
+// Migration is a versioned change of the schema or the data. The applied
+// migrations are recorded in the schema_migrations collection.
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      func(ctx context.Context, db *mongo.Database) error
+	Down    func(ctx context.Context, db *mongo.Database) error
+}
+
+func (m *Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+var registry = make(map[uint64]*Migration)
+
+func register(m *Migration) {
+	if _, ok := registry[m.Version]; ok {
+		panic(fmt.Errorf("duplicate migration for version %d", m.Version))
+	}
+
+	registry[m.Version] = m
+}
+
+// Migrations returns the registered migrations ordered by version.
+func Migrations() []*Migration {
+	items := make([]*Migration, 0, len(registry))
+	for _, m := range registry {
+		items = append(items, m)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Version < items[j].Version
+	})
+
+	return items
+}
+
+type Status struct {
+	Migration *Migration
+	Applied   *models.SchemaMigration
+}
+
+// Statuses returns every registered migration along with its record, which is
+// nil for the pending ones.
+func Statuses(ctx context.Context, db *mongo.Database) ([]*Status, error) {
+	dMigrations, err := database.SchemaMigrationFind(ctx, db, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[uint64]*models.SchemaMigration)
+	for i := 0; i < len(dMigrations); i++ {
+		m[dMigrations[i].Version] = dMigrations[i]
+	}
+
+	var items []*Status
+	for _, migration := range Migrations() {
+		items = append(items, &Status{
+			Migration: migration,
+			Applied:   m[migration.Version],
+		})
+	}
+
+	return items, nil
+}
+
+// Up applies the pending migrations up to and including the target version, a
+// zero target applies all of them. The migrations which were applied, or would
+// have been applied on a dry run, are returned.
+func Up(ctx context.Context, db *mongo.Database, target uint64, dryRun bool) (items []*Migration, err error) {
+	statuses, err := Statuses(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, status := range statuses {
+		if status.Applied != nil {
+			continue
+		}
+		if target != 0 && status.Migration.Version > target {
+			break
+		}
+
+		items = append(items, status.Migration)
+		if dryRun {
+			continue
+		}
+
+		log.Println("MigrationUp", status.Migration)
+		err := status.Migration.Up(ctx, db)
+		if err != nil && !errors.Is(err, ErrSkipped) {
+			return items, fmt.Errorf("migration %s: %w", status.Migration, err)
+		}
+
+		dMigration := models.SchemaMigration{
+			Version:   status.Migration.Version,
+			Name:      status.Migration.Name,
+			AppliedAt: time.Now().UTC(),
+			Skipped:   err != nil,
+		}
+
+		if _, err := database.SchemaMigrationInsertOne(ctx, db, &dMigration); err != nil {
+			return items, err
+		}
+	}
+
+	return items, nil
+}
+
+// Down reverts the applied migrations above the target version, the latest
+// one first.
+func Down(ctx context.Context, db *mongo.Database, target uint64, dryRun bool) (items []*Migration, err error) {
+	statuses, err := Statuses(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(statuses) - 1; i >= 0; i-- {
+		status := statuses[i]
+		if status.Migration.Version <= target {
+			break
+		}
+		if status.Applied == nil {
+			continue
+		}
+
+		items = append(items, status.Migration)
+		if dryRun {
+			continue
+		}
+
+		log.Println("MigrationDown", status.Migration)
+		if !status.Applied.Skipped {
+			if status.Migration.Down == nil {
+				return items, fmt.Errorf("migration %s: %w", status.Migration, ErrIrreversible)
+			}
+			if err := status.Migration.Down(ctx, db); err != nil {
+				return items, fmt.Errorf("migration %s: %w", status.Migration, err)
+			}
+		}
+
+		filter := bson.M{
+			"version": status.Migration.Version,
+		}
+
+		if err := database.SchemaMigrationDeleteMany(ctx, db, filter); err != nil {
+			return items, err
+		}
+	}
+
+	return items, nil
+}
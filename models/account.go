@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/sentinel-official/explorer/utils"
+)
+
+type Account struct {
+	Addr string `json:"addr,omitempty" bson:"addr"`
+
+	CreateHeight    int64     `json:"create_height,omitempty" bson:"create_height"`
+	CreateTimestamp time.Time `json:"create_timestamp,omitempty" bson:"create_timestamp"`
+	CreateTxHash    string    `json:"create_tx_hash,omitempty" bson:"create_tx_hash"`
+}
+
+func (a *Account) String() string {
+	return utils.MustMarshalIndentToString(a)
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+type Delegation struct {
+	AccAddr string      `json:"acc_addr,omitempty" bson:"acc_addr"`
+	ValAddr string      `json:"val_addr,omitempty" bson:"val_addr"`
+	Amount  *types.Coin `json:"amount,omitempty" bson:"amount"`
+
+	Height    int64     `json:"height,omitempty" bson:"height"`
+	Timestamp time.Time `json:"timestamp,omitempty" bson:"timestamp"`
+	TxHash    string    `json:"tx_hash,omitempty" bson:"tx_hash"`
+}
+
+func (d *Delegation) String() string {
+	return utils.MustMarshalIndentToString(d)
+}
@@ -0,0 +1,15 @@
+package models
+
+import (
+	"github.com/sentinel-official/explorer/utils"
+)
+
+type DenomTrace struct {
+	Hash      string `json:"hash,omitempty" bson:"hash"`
+	Path      string `json:"path,omitempty" bson:"path"`
+	BaseDenom string `json:"base_denom,omitempty" bson:"base_denom"`
+}
+
+func (dt *DenomTrace) String() string {
+	return utils.MustMarshalIndentToString(dt)
+}
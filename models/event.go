@@ -22,6 +22,7 @@ type Event struct {
 	GrantedBytes   string           `json:"granted_bytes,omitempty" bson:"granted_bytes,omitempty"`
 	HourlyPrices   types.Coins      `json:"hourly_prices,omitempty" bson:"hourly_prices,omitempty"`
 	Identity       string           `json:"identity,omitempty" bson:"identity,omitempty"`
+	LeaseID        uint64           `json:"lease_id,omitempty" bson:"lease_id,omitempty"`
 	Name           string           `json:"name,omitempty" bson:"name,omitempty"`
 	NodeAddr       string           `json:"node_addr,omitempty" bson:"node_addr,omitempty"`
 	PlanID         uint64           `json:"plan_id,omitempty" bson:"plan_id,omitempty"`
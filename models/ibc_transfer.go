@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/sentinel-official/explorer/utils"
+)
+
+const (
+	IBCTransferDirectionIncoming = "incoming"
+	IBCTransferDirectionOutgoing = "outgoing"
+
+	IBCTransferStatusPending      = "pending"
+	IBCTransferStatusAcknowledged = "acknowledged"
+	IBCTransferStatusReceived     = "received"
+	IBCTransferStatusFailed       = "failed"
+	IBCTransferStatusTimedOut     = "timed_out"
+)
+
+type IBCTransfer struct {
+	Direction  string `json:"direction,omitempty" bson:"direction"`
+	SrcPort    string `json:"src_port,omitempty" bson:"src_port"`
+	SrcChannel string `json:"src_channel,omitempty" bson:"src_channel"`
+	DstPort    string `json:"dst_port,omitempty" bson:"dst_port"`
+	DstChannel string `json:"dst_channel,omitempty" bson:"dst_channel"`
+	Sequence   uint64 `json:"sequence,omitempty" bson:"sequence"`
+
+	Sender   string `json:"sender,omitempty" bson:"sender"`
+	Receiver string `json:"receiver,omitempty" bson:"receiver"`
+	Denom    string `json:"denom,omitempty" bson:"denom"`
+	Amount   string `json:"amount,omitempty" bson:"amount"`
+	Memo     string `json:"memo,omitempty" bson:"memo"`
+
+	Height    int64     `json:"height,omitempty" bson:"height"`
+	Timestamp time.Time `json:"timestamp,omitempty" bson:"timestamp"`
+	TxHash    string    `json:"tx_hash,omitempty" bson:"tx_hash"`
+
+	Status          string    `json:"status,omitempty" bson:"status"`
+	StatusError     string    `json:"status_error,omitempty" bson:"status_error"`
+	StatusHeight    int64     `json:"status_height,omitempty" bson:"status_height"`
+	StatusTimestamp time.Time `json:"status_timestamp,omitempty" bson:"status_timestamp"`
+	StatusTxHash    string    `json:"status_tx_hash,omitempty" bson:"status_tx_hash"`
+}
+
+func (it *IBCTransfer) String() string {
+	return utils.MustMarshalIndentToString(it)
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+type Lease struct {
+	ID                 uint64      `json:"id,omitempty" bson:"id"`
+	NodeAddr           string      `json:"node_addr,omitempty" bson:"node_addr"`
+	ProvAddr           string      `json:"prov_addr,omitempty" bson:"prov_addr"`
+	Hours              int64       `json:"hours" bson:"hours"`
+	MaxHours           int64       `json:"max_hours,omitempty" bson:"max_hours"`
+	Price              *types.Coin `json:"price,omitempty" bson:"price"`
+	RenewalPricePolicy string      `json:"renewal_price_policy,omitempty" bson:"renewal_price_policy"`
+	PayoutAt           time.Time   `json:"payout_at,omitempty" bson:"payout_at"`
+
+	StartHeight    int64     `json:"start_height,omitempty" bson:"start_height"`
+	StartTimestamp time.Time `json:"start_timestamp,omitempty" bson:"start_timestamp"`
+	StartTxHash    string    `json:"start_tx_hash,omitempty" bson:"start_tx_hash"`
+	EndHeight      int64     `json:"end_height,omitempty" bson:"end_height"`
+	EndTimestamp   time.Time `json:"end_timestamp,omitempty" bson:"end_timestamp"`
+	EndTxHash      string    `json:"end_tx_hash,omitempty" bson:"end_tx_hash"`
+
+	Payment       *types.Coin `json:"payment,omitempty" bson:"payment"`
+	StakingReward *types.Coin `json:"staking_reward,omitempty" bson:"staking_reward"`
+	Refund        *types.Coin `json:"refund,omitempty" bson:"refund"`
+
+	Status          string    `json:"status,omitempty" bson:"status"`
+	StatusHeight    int64     `json:"status_height,omitempty" bson:"status_height"`
+	StatusTimestamp time.Time `json:"status_timestamp,omitempty" bson:"status_timestamp"`
+	StatusTxHash    string    `json:"status_tx_hash,omitempty" bson:"status_tx_hash"`
+}
+
+func (l *Lease) String() string {
+	return utils.MustMarshalIndentToString(l)
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/sentinel-official/explorer/utils"
+)
+
+type Price struct {
+	CoinID   string `json:"coin_id,omitempty" bson:"coin_id"`
+	Denom    string `json:"denom,omitempty" bson:"denom"`
+	Exponent int64  `json:"exponent,omitempty" bson:"exponent"`
+	Quote    string `json:"quote,omitempty" bson:"quote"`
+
+	Timeframe string    `json:"timeframe,omitempty" bson:"timeframe"`
+	Timestamp time.Time `json:"timestamp,omitempty" bson:"timestamp"`
+
+	Open  float64 `json:"open,omitempty" bson:"open"`
+	High  float64 `json:"high,omitempty" bson:"high"`
+	Low   float64 `json:"low,omitempty" bson:"low"`
+	Close float64 `json:"close,omitempty" bson:"close"`
+}
+
+func (p *Price) String() string {
+	return utils.MustMarshalIndentToString(p)
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+type ProposalTallyResult struct {
+	Yes        string `json:"yes,omitempty" bson:"yes"`
+	Abstain    string `json:"abstain,omitempty" bson:"abstain"`
+	No         string `json:"no,omitempty" bson:"no"`
+	NoWithVeto string `json:"no_with_veto,omitempty" bson:"no_with_veto"`
+}
+
+type Proposal struct {
+	ID           uint64      `json:"id,omitempty" bson:"id"`
+	Type         string      `json:"type,omitempty" bson:"type"`
+	Title        string      `json:"title,omitempty" bson:"title"`
+	Description  string      `json:"description,omitempty" bson:"description"`
+	Content      bson.M      `json:"content,omitempty" bson:"content"`
+	Proposer     string      `json:"proposer,omitempty" bson:"proposer"`
+	TotalDeposit types.Coins `json:"total_deposit,omitempty" bson:"total_deposit"`
+
+	SubmitHeight    int64     `json:"submit_height,omitempty" bson:"submit_height"`
+	SubmitTimestamp time.Time `json:"submit_timestamp,omitempty" bson:"submit_timestamp"`
+	SubmitTxHash    string    `json:"submit_tx_hash,omitempty" bson:"submit_tx_hash"`
+
+	VotingStartHeight    int64     `json:"voting_start_height,omitempty" bson:"voting_start_height"`
+	VotingStartTimestamp time.Time `json:"voting_start_timestamp,omitempty" bson:"voting_start_timestamp"`
+	VotingStartTxHash    string    `json:"voting_start_tx_hash,omitempty" bson:"voting_start_tx_hash"`
+
+	FinalTallyResult *ProposalTallyResult `json:"final_tally_result,omitempty" bson:"final_tally_result"`
+
+	Status          string    `json:"status,omitempty" bson:"status"`
+	StatusHeight    int64     `json:"status_height,omitempty" bson:"status_height"`
+	StatusTimestamp time.Time `json:"status_timestamp,omitempty" bson:"status_timestamp"`
+}
+
+func (p *Proposal) String() string {
+	return utils.MustMarshalIndentToString(p)
+}
+
+// NewProposalFromGovProposal returns the proposal as held by the state of the
+// gov module. The state does not keep the proposer and the submit height, so
+// these are left empty.
+func NewProposalFromGovProposal(v *govtypes.Proposal) (*Proposal, error) {
+	buf, err := types.EncCfg.Codec.MarshalJSON(v.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	var content bson.M
+	if err := json.Unmarshal(buf, &content); err != nil {
+		return nil, err
+	}
+
+	contentType, _ := content["@type"].(string)
+	delete(content, "@type")
+
+	title, _ := content["title"].(string)
+	description, _ := content["description"].(string)
+
+	item := &Proposal{
+		ID:              v.ProposalId,
+		Type:            contentType,
+		Title:           title,
+		Description:     description,
+		Content:         content,
+		TotalDeposit:    types.NewCoins(v.TotalDeposit),
+		SubmitTimestamp: v.SubmitTime,
+		Status:          v.Status.String(),
+	}
+	if v.Status != govtypes.StatusDepositPeriod {
+		item.VotingStartTimestamp = v.VotingStartTime
+	}
+
+	return item, nil
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+type ProposalDeposit struct {
+	ProposalID uint64      `json:"proposal_id,omitempty" bson:"proposal_id"`
+	Depositor  string      `json:"depositor,omitempty" bson:"depositor"`
+	Coins      types.Coins `json:"coins,omitempty" bson:"coins"`
+	Height     int64       `json:"height,omitempty" bson:"height"`
+	Timestamp  time.Time   `json:"timestamp,omitempty" bson:"timestamp"`
+	TxHash     string      `json:"tx_hash,omitempty" bson:"tx_hash"`
+}
+
+func (pd *ProposalDeposit) String() string {
+	return utils.MustMarshalIndentToString(pd)
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestNewProposalFromGovProposal(t *testing.T) {
+	submitTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	content := govtypes.NewTextProposal("Title", "Description")
+
+	proposal, err := govtypes.NewProposal(content, 5, submitTime, submitTime.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proposal.TotalDeposit = sdk.NewCoins(sdk.NewInt64Coin("udvpn", 10))
+
+	item, err := NewProposalFromGovProposal(&proposal)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.ID != 5 {
+		t.Fatalf("got id %d, want 5", item.ID)
+	}
+	if item.Type != "/cosmos.gov.v1beta1.TextProposal" {
+		t.Fatalf("got type %s", item.Type)
+	}
+	if item.Title != "Title" || item.Description != "Description" {
+		t.Fatalf("got title %q and description %q", item.Title, item.Description)
+	}
+	if _, ok := item.Content["@type"]; ok {
+		t.Fatal("expected the @type key to be removed from the content")
+	}
+	if item.Status != govtypes.StatusDepositPeriod.String() {
+		t.Fatalf("got status %s", item.Status)
+	}
+	if !item.VotingStartTimestamp.IsZero() {
+		t.Fatalf("got voting start %s, want zero", item.VotingStartTimestamp)
+	}
+	if len(item.TotalDeposit) != 1 || item.TotalDeposit[0].Amount != "10" {
+		t.Fatalf("got total deposit %v", item.TotalDeposit)
+	}
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	govtypes "github.com/sentinel-official/explorer/types/gov"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+type ProposalVote struct {
+	ProposalID uint64                         `json:"proposal_id,omitempty" bson:"proposal_id"`
+	Voter      string                         `json:"voter,omitempty" bson:"voter"`
+	Options    []*govtypes.WeightedVoteOption `json:"options,omitempty" bson:"options"`
+	Height     int64                          `json:"height,omitempty" bson:"height"`
+	Timestamp  time.Time                      `json:"timestamp,omitempty" bson:"timestamp"`
+	TxHash     string                         `json:"tx_hash,omitempty" bson:"tx_hash"`
+}
+
+func (pv *ProposalVote) String() string {
+	return utils.MustMarshalIndentToString(pv)
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+// RewardWithdrawal is a withdrawal of the rewards of a delegation, or with
+// Commission set, of the commission of a validator by its operator account.
+type RewardWithdrawal struct {
+	AccAddr    string      `json:"acc_addr,omitempty" bson:"acc_addr"`
+	ValAddr    string      `json:"val_addr,omitempty" bson:"val_addr"`
+	Coins      types.Coins `json:"coins,omitempty" bson:"coins"`
+	Commission bool        `json:"commission,omitempty" bson:"commission,omitempty"`
+
+	Height    int64     `json:"height,omitempty" bson:"height"`
+	Timestamp time.Time `json:"timestamp,omitempty" bson:"timestamp"`
+	TxHash    string    `json:"tx_hash,omitempty" bson:"tx_hash"`
+}
+
+func (rw *RewardWithdrawal) String() string {
+	return utils.MustMarshalIndentToString(rw)
+}
@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/sentinel-official/explorer/utils"
+)
+
+type SchemaMigration struct {
+	Version   uint64    `json:"version,omitempty" bson:"version"`
+	Name      string    `json:"name,omitempty" bson:"name"`
+	AppliedAt time.Time `json:"applied_at,omitempty" bson:"applied_at"`
+	Skipped   bool      `json:"skipped,omitempty" bson:"skipped,omitempty"`
+}
+
+func (sm *SchemaMigration) String() string {
+	return utils.MustMarshalIndentToString(sm)
+}
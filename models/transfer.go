@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+type Transfer struct {
+	FromAddr string      `json:"from_addr,omitempty" bson:"from_addr"`
+	ToAddr   string      `json:"to_addr,omitempty" bson:"to_addr"`
+	Coins    types.Coins `json:"coins,omitempty" bson:"coins"`
+
+	Height    int64     `json:"height,omitempty" bson:"height"`
+	Timestamp time.Time `json:"timestamp,omitempty" bson:"timestamp"`
+	TxHash    string    `json:"tx_hash,omitempty" bson:"tx_hash"`
+}
+
+func (t *Transfer) String() string {
+	return utils.MustMarshalIndentToString(t)
+}
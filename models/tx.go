@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	abcitypes "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/bytes"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -56,17 +57,23 @@ func (m Messages) WithAuthzMsgExecMessages() (items Messages) {
 	for i := 0; i < len(m); i++ {
 		items = append(items, m[i])
 		if strings.Contains(m[i].Type, "cosmos.authz") && strings.Contains(m[i].Type, "MsgExec") {
-			msgs := m[i].Data["msgs"].(bson.A)
+			msgs := toSlice(m[i].Data["msgs"])
 			for j := 0; j < len(msgs); j++ {
+				data := toMap(msgs[j])
+				if data == nil {
+					continue
+				}
+
+				msgType, _ := data["@type"].(string)
 				item := &Message{
 					Data: func() bson.M {
-						m := make(bson.M)
-						maps.Copy(m, msgs[j].(bson.M))
+						m := make(bson.M, len(data))
+						maps.Copy(m, data)
 						delete(m, "@type")
 
 						return m
 					}(),
-					Type: msgs[j].(bson.M)["@type"].(string),
+					Type: msgType,
 				}
 
 				items = append(items, item)
@@ -77,6 +84,165 @@ func (m Messages) WithAuthzMsgExecMessages() (items Messages) {
 	return items
 }
 
+// toSlice returns the array value as decoded either from BSON or from JSON.
+func toSlice(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case bson.A:
+		return v
+	case []interface{}:
+		return v
+	default:
+		return nil
+	}
+}
+
+// toMap returns the document value as decoded either from BSON or from JSON.
+func toMap(v interface{}) bson.M {
+	switch v := v.(type) {
+	case bson.M:
+		return v
+	case map[string]interface{}:
+		return v
+	default:
+		return nil
+	}
+}
+
+// Msg decodes the message back into its SDK type using the registered interfaces.
+func (m *Message) Msg() (sdk.Msg, error) {
+	data := make(bson.M, len(m.Data)+1)
+	maps.Copy(data, m.Data)
+	data["@type"] = m.Type
+
+	buf, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg sdk.Msg
+	if err := types.EncCfg.Codec.UnmarshalInterfaceJSON(buf, &msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// Types returns the distinct message types, including the messages wrapped by an authz MsgExec.
+func (m Messages) Types() []string {
+	var (
+		items    = m.WithAuthzMsgExecMessages()
+		seen     = make(map[string]bool)
+		msgTypes = make([]string, 0, len(items))
+	)
+
+	for i := 0; i < len(items); i++ {
+		if seen[items[i].Type] {
+			continue
+		}
+
+		seen[items[i].Type] = true
+		msgTypes = append(msgTypes, items[i].Type)
+	}
+
+	return msgTypes
+}
+
+// NewMsgTypes returns the distinct type URLs of the messages, including the
+// messages wrapped by an authz MsgExec.
+func NewMsgTypes(v []sdk.Msg) []string {
+	var (
+		items    = withAuthzMsgExecMsgs(v)
+		seen     = make(map[string]bool)
+		msgTypes = make([]string, 0, len(items))
+	)
+
+	for _, item := range items {
+		s := utils.MsgTypeURL(item)
+		if seen[s] {
+			continue
+		}
+
+		seen[s] = true
+		msgTypes = append(msgTypes, s)
+	}
+
+	return msgTypes
+}
+
+// NewMsgSigners returns the distinct signers of the messages, including the
+// granters of the messages wrapped by an authz MsgExec.
+func NewMsgSigners(v []sdk.Msg) []string {
+	var (
+		items   = withAuthzMsgExecMsgs(v)
+		seen    = make(map[string]bool)
+		signers = make([]string, 0, len(items))
+	)
+
+	for _, item := range items {
+		for _, addr := range item.GetSigners() {
+			s := addr.String()
+			if seen[s] {
+				continue
+			}
+
+			seen[s] = true
+			signers = append(signers, s)
+		}
+	}
+
+	return signers
+}
+
+// withAuthzMsgExecMsgs returns the messages followed by the ones wrapped by each authz MsgExec.
+func withAuthzMsgExecMsgs(v []sdk.Msg) (items []sdk.Msg) {
+	for _, item := range v {
+		items = append(items, item)
+
+		exec, ok := item.(*authz.MsgExec)
+		if !ok {
+			continue
+		}
+
+		msgs, err := exec.GetMessages()
+		if err != nil {
+			continue
+		}
+
+		items = append(items, msgs...)
+	}
+
+	return items
+}
+
+// Signers returns the distinct signers of the messages, including the granters of
+// the messages wrapped by an authz MsgExec. The messages of unknown types are skipped.
+func (m Messages) Signers() []string {
+	var (
+		items   = m.WithAuthzMsgExecMessages()
+		seen    = make(map[string]bool)
+		signers = make([]string, 0, len(items))
+	)
+
+	for i := 0; i < len(items); i++ {
+		msg, err := items[i].Msg()
+		if err != nil {
+			continue
+		}
+
+		for _, addr := range msg.GetSigners() {
+			s := addr.String()
+			if seen[s] {
+				continue
+			}
+
+			seen[s] = true
+			signers = append(signers, s)
+		}
+	}
+
+	return signers
+}
+
 type TxResult struct {
 	Codespace string       `json:"codespace,omitempty" bson:"codespace"`
 	Code      uint32       `json:"code,omitempty" bson:"code"`
@@ -147,9 +313,11 @@ type Tx struct {
 	Index         int           `json:"index,omitempty" bson:"index"`
 	Memo          string        `json:"memo,omitempty" bson:"memo"`
 	Messages      Messages      `json:"messages,omitempty" bson:"messages"`
+	MsgTypes      []string      `json:"msg_types,omitempty" bson:"msg_types"`
 	Payer         string        `json:"payer,omitempty" bson:"payer"`
 	Result        *TxResult     `json:"result,omitempty" bson:"result"`
 	SignerInfos   TxSignerInfos `json:"signer_infos,omitempty" bson:"signer_infos"`
+	Signers       []string      `json:"signers,omitempty" bson:"signers"`
 	TimeoutHeight uint64        `json:"timeout_height,omitempty" bson:"timeout_height"`
 	Timestamp     time.Time     `json:"timestamp,omitempty" bson:"timestamp"`
 }
@@ -163,6 +331,7 @@ func NewTx(v tmtypes.Tx) *Tx {
 	}
 
 	tx := t.(authsigning.Tx)
+
 	return &Tx{
 		Fee:           types.NewCoins(tx.GetFee()),
 		GasLimit:      tx.GetGas(),
@@ -172,8 +341,10 @@ func NewTx(v tmtypes.Tx) *Tx {
 		Index:         0,
 		Memo:          tx.GetMemo(),
 		Messages:      NewMessages(tx.GetMsgs()),
+		MsgTypes:      NewMsgTypes(tx.GetMsgs()),
 		Payer:         tx.FeePayer().String(),
 		SignerInfos:   NewTxSignerInfosFromTx(tx),
+		Signers:       NewMsgSigners(tx.GetMsgs()),
 		TimeoutHeight: tx.GetTimeoutHeight(),
 		Timestamp:     time.Time{},
 	}
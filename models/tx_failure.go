@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/utils"
+)
+
+type TxFailure struct {
+	AccAddr  string `json:"acc_addr,omitempty" bson:"acc_addr"`
+	NodeAddr string `json:"node_addr,omitempty" bson:"node_addr"`
+	MsgIndex int    `json:"msg_index" bson:"msg_index"`
+	MsgType  string `json:"msg_type,omitempty" bson:"msg_type"`
+	MsgData  bson.M `json:"msg_data,omitempty" bson:"msg_data"`
+
+	Codespace string `json:"codespace,omitempty" bson:"codespace"`
+	Code      uint32 `json:"code,omitempty" bson:"code"`
+	Log       string `json:"log,omitempty" bson:"log"`
+
+	Height    int64     `json:"height,omitempty" bson:"height"`
+	Timestamp time.Time `json:"timestamp,omitempty" bson:"timestamp"`
+	TxHash    string    `json:"tx_hash,omitempty" bson:"tx_hash"`
+}
+
+func (tf *TxFailure) String() string {
+	return utils.MustMarshalIndentToString(tf)
+}
@@ -0,0 +1,94 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+func newAuthzMsgExecTx(t *testing.T) (tx []byte, grantee, granter sdk.AccAddress) {
+	grantee = sdk.AccAddress(make([]byte, 20))
+	granter = sdk.AccAddress(append(make([]byte, 19), 1))
+	recipient := sdk.AccAddress(append(make([]byte, 19), 2))
+
+	send := banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("udvpn", 1)))
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{send})
+
+	builder := types.EncCfg.TxConfig.NewTxBuilder()
+	if err := builder.SetMsgs(&exec); err != nil {
+		t.Fatal(err)
+	}
+
+	sig := signing.SignatureV2{
+		PubKey: secp256k1.GenPrivKey().PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+			Signature: []byte{1},
+		},
+	}
+	if err := builder.SetSignatures(sig); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := types.EncCfg.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tx, grantee, granter
+}
+
+func TestNewTxAuthzMsgExec(t *testing.T) {
+	buf, grantee, granter := newAuthzMsgExecTx(t)
+	tx := NewTx(buf)
+
+	wantTypes := []string{"/cosmos.authz.v1beta1.MsgExec", "/cosmos.bank.v1beta1.MsgSend"}
+	if len(tx.MsgTypes) != len(wantTypes) {
+		t.Fatalf("got msg types %v, want %v", tx.MsgTypes, wantTypes)
+	}
+	for i := range wantTypes {
+		if tx.MsgTypes[i] != wantTypes[i] {
+			t.Fatalf("got msg types %v, want %v", tx.MsgTypes, wantTypes)
+		}
+	}
+
+	wantSigners := []string{grantee.String(), granter.String()}
+	if len(tx.Signers) != len(wantSigners) {
+		t.Fatalf("got signers %v, want %v", tx.Signers, wantSigners)
+	}
+	for i := range wantSigners {
+		if tx.Signers[i] != wantSigners[i] {
+			t.Fatalf("got signers %v, want %v", tx.Signers, wantSigners)
+		}
+	}
+}
+
+func TestMessagesWithAuthzMsgExecMessages(t *testing.T) {
+	buf, _, granter := newAuthzMsgExecTx(t)
+	tx := NewTx(buf)
+
+	items := tx.Messages.WithAuthzMsgExecMessages()
+	if len(items) != 2 {
+		t.Fatalf("got %d messages, want 2", len(items))
+	}
+	if items[1].Type != "/cosmos.bank.v1beta1.MsgSend" {
+		t.Fatalf("got type %s, want /cosmos.bank.v1beta1.MsgSend", items[1].Type)
+	}
+	if _, ok := items[1].Data["@type"]; ok {
+		t.Fatal("expected the @type key to be removed from the data")
+	}
+	if items[1].Data["from_address"] != granter.String() {
+		t.Fatalf("got from_address %v, want %s", items[1].Data["from_address"], granter)
+	}
+
+	signers := tx.Messages.Signers()
+	if len(signers) != 2 || signers[1] != granter.String() {
+		t.Fatalf("got signers %v", signers)
+	}
+}
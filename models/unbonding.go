@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+type Unbonding struct {
+	AccAddr        string      `json:"acc_addr,omitempty" bson:"acc_addr"`
+	ValAddr        string      `json:"val_addr,omitempty" bson:"val_addr"`
+	Amount         *types.Coin `json:"amount,omitempty" bson:"amount"`
+	CompletionTime time.Time   `json:"completion_time,omitempty" bson:"completion_time"`
+
+	StartHeight    int64     `json:"start_height,omitempty" bson:"start_height"`
+	StartTimestamp time.Time `json:"start_timestamp,omitempty" bson:"start_timestamp"`
+	StartTxHash    string    `json:"start_tx_hash,omitempty" bson:"start_tx_hash"`
+	EndHeight      int64     `json:"end_height,omitempty" bson:"end_height"`
+	EndTimestamp   time.Time `json:"end_timestamp,omitempty" bson:"end_timestamp"`
+
+	Status string `json:"status,omitempty" bson:"status"`
+}
+
+func (u *Unbonding) String() string {
+	return utils.MustMarshalIndentToString(u)
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"github.com/sentinel-official/explorer/utils"
+)
+
+type Validator struct {
+	ConsAddr string `json:"cons_addr,omitempty" bson:"cons_addr"`
+	OperAddr string `json:"oper_addr,omitempty" bson:"oper_addr"`
+
+	Moniker  string `json:"moniker,omitempty" bson:"moniker"`
+	Identity string `json:"identity,omitempty" bson:"identity"`
+	Website  string `json:"website,omitempty" bson:"website"`
+	Details  string `json:"details,omitempty" bson:"details"`
+
+	Jailed      bool   `json:"jailed,omitempty" bson:"jailed"`
+	Status      string `json:"status,omitempty" bson:"status"`
+	Tokens      string `json:"tokens,omitempty" bson:"tokens"`
+	VotingPower int64  `json:"voting_power,omitempty" bson:"voting_power"`
+
+	ProposedBlocks int64            `json:"proposed_blocks,omitempty" bson:"proposed_blocks"`
+	SignedBlocks   int64            `json:"signed_blocks,omitempty" bson:"signed_blocks"`
+	MissedBlocks   int64            `json:"missed_blocks,omitempty" bson:"missed_blocks"`
+	UptimeWindows  map[string]int64 `json:"uptime_windows,omitempty" bson:"uptime_windows"`
+
+	StartHeight int64 `json:"start_height,omitempty" bson:"start_height"`
+	LastHeight  int64 `json:"last_height,omitempty" bson:"last_height"`
+}
+
+func (v *Validator) String() string {
+	return utils.MustMarshalIndentToString(v)
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/sentinel-official/explorer/utils"
+)
+
+type ValidatorBlock struct {
+	ConsAddr  string    `json:"cons_addr,omitempty" bson:"cons_addr"`
+	Height    int64     `json:"height,omitempty" bson:"height"`
+	Timestamp time.Time `json:"timestamp,omitempty" bson:"timestamp"`
+	Proposed  bool      `json:"proposed,omitempty" bson:"proposed"`
+	Missed    bool      `json:"missed,omitempty" bson:"missed"`
+}
+
+func (vb *ValidatorBlock) String() string {
+	return utils.MustMarshalIndentToString(vb)
+}
@@ -0,0 +1,42 @@
+package operations
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/types"
+)
+
+func NewAccountCreate(
+	db *mongo.Database,
+	addr string, height int64, timestamp time.Time, txHash string,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"addr": addr,
+		}
+		update := bson.M{
+			"$setOnInsert": bson.M{
+				"create_height":    height,
+				"create_timestamp": timestamp,
+				"create_tx_hash":   txHash,
+			},
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+		opts := options.FindOneAndUpdate().
+			SetProjection(projection).
+			SetUpsert(true)
+
+		if _, err := database.AccountFindOneAndUpdate(ctx, db, filter, update, opts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
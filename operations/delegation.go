@@ -0,0 +1,152 @@
+package operations
+
+import (
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/types"
+	"github.com/sentinel-official/explorer/utils"
+)
+
+func NewDelegationAdd(
+	db *mongo.Database,
+	accAddr, valAddr string, amount *types.Coin, height int64, timestamp time.Time, txHash string,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"acc_addr": accAddr,
+			"val_addr": valAddr,
+		}
+		projection := bson.M{
+			"_id":    0,
+			"amount": 1,
+		}
+		findOneOpts := options.FindOne().
+			SetProjection(projection)
+
+		item, err := database.DelegationFindOne(ctx, db, filter, findOneOpts)
+		if err != nil {
+			return err
+		}
+
+		value := amount.Copy()
+		if item != nil && item.Amount != nil {
+			value = item.Amount.Copy().Add(amount.Amount)
+		}
+
+		update := bson.M{
+			"$set": bson.M{
+				"amount":    value,
+				"height":    height,
+				"timestamp": timestamp,
+				"tx_hash":   txHash,
+			},
+		}
+		projection = bson.M{
+			"_id": 1,
+		}
+		opts := options.FindOneAndUpdate().
+			SetProjection(projection).
+			SetUpsert(true)
+
+		if _, err := database.DelegationFindOneAndUpdate(ctx, db, filter, update, opts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func NewDelegationSubtract(
+	db *mongo.Database,
+	accAddr, valAddr string, amount *types.Coin, height int64, timestamp time.Time, txHash string,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"acc_addr": accAddr,
+			"val_addr": valAddr,
+		}
+		projection := bson.M{
+			"_id":    0,
+			"amount": 1,
+		}
+		findOneOpts := options.FindOne().
+			SetProjection(projection)
+
+		item, err := database.DelegationFindOne(ctx, db, filter, findOneOpts)
+		if err != nil {
+			return err
+		}
+		// The delegations made before the first indexed height are not known,
+		// and the slashed ones hold more than the chain does. Both diverge from
+		// the chain, which is to be surfaced rather than guessed.
+		if item == nil || item.Amount == nil {
+			return fmt.Errorf("delegation of %s to %s does not exist", accAddr, valAddr)
+		}
+
+		value := item.Amount.Copy().Sub(amount.Amount)
+		if utils.MustIntFromString(value.Amount).IsNegative() {
+			return fmt.Errorf("delegation of %s to %s is less than %s", accAddr, valAddr, amount.Amount)
+		}
+
+		update := bson.M{
+			"$set": bson.M{
+				"amount":    value,
+				"height":    height,
+				"timestamp": timestamp,
+				"tx_hash":   txHash,
+			},
+		}
+		projection = bson.M{
+			"_id": 1,
+		}
+		opts := options.FindOneAndUpdate().
+			SetProjection(projection)
+
+		if _, err := database.DelegationFindOneAndUpdate(ctx, db, filter, update, opts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// NewDelegationSet sets the amount of the delegation to the one held by the
+// chain, which accounts for the slashing and for the delegations made before
+// the first indexed height.
+func NewDelegationSet(
+	db *mongo.Database,
+	accAddr, valAddr string, amount *types.Coin, height int64, timestamp time.Time, txHash string,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"acc_addr": accAddr,
+			"val_addr": valAddr,
+		}
+		update := bson.M{
+			"$set": bson.M{
+				"amount":    amount,
+				"height":    height,
+				"timestamp": timestamp,
+				"tx_hash":   txHash,
+			},
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+		opts := options.FindOneAndUpdate().
+			SetProjection(projection).
+			SetUpsert(true)
+
+		if _, err := database.DelegationFindOneAndUpdate(ctx, db, filter, update, opts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
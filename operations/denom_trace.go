@@ -0,0 +1,37 @@
+package operations
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+func NewDenomTraceUpsert(
+	db *mongo.Database,
+	v *models.DenomTrace,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"hash": v.Hash,
+		}
+		update := bson.M{
+			"$set": v,
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+		opts := options.FindOneAndUpdate().
+			SetProjection(projection).
+			SetUpsert(true)
+
+		if _, err := database.DenomTraceFindOneAndUpdate(ctx, db, filter, update, opts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,62 @@
+package operations
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+func NewIBCTransferCreate(
+	db *mongo.Database,
+	v *models.IBCTransfer,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		if _, err := database.IBCTransferInsertOne(ctx, db, v); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// NewIBCTransferUpdateStatus updates the status of the outgoing transfer sent
+// with the packet of the given source port, channel and sequence.
+func NewIBCTransferUpdateStatus(
+	db *mongo.Database,
+	srcPort, srcChannel string, sequence uint64, status, statusError string, height int64, timestamp time.Time, txHash string,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"direction":   models.IBCTransferDirectionOutgoing,
+			"src_port":    srcPort,
+			"src_channel": srcChannel,
+			"sequence":    sequence,
+		}
+		update := bson.M{
+			"$set": bson.M{
+				"status":           status,
+				"status_error":     statusError,
+				"status_height":    height,
+				"status_timestamp": timestamp,
+				"status_tx_hash":   txHash,
+			},
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+		opts := options.FindOneAndUpdate().
+			SetProjection(projection)
+
+		if _, err := database.IBCTransferFindOneAndUpdate(ctx, db, filter, update, opts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,169 @@
+package operations
+
+import (
+	"time"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+func NewLeaseCreate(
+	db *mongo.Database,
+	v *models.Lease,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		if _, err := database.LeaseInsertOne(ctx, db, v); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func NewLeaseUpdateDetails(
+	db *mongo.Database,
+	id uint64, hours, maxHours int64, price *types.Coin, payoutAt time.Time, refund *types.Coin,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"id": id,
+		}
+
+		updateSet := bson.M{}
+		if hours != -1 {
+			updateSet["hours"] = hours
+		}
+		if maxHours != -1 {
+			updateSet["max_hours"] = maxHours
+		}
+		if price != nil {
+			updateSet["price"] = price
+		}
+		if !payoutAt.IsZero() {
+			updateSet["payout_at"] = payoutAt
+		}
+		if refund != nil {
+			updateSet["refund"] = refund
+		}
+
+		update := bson.M{
+			"$set": updateSet,
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+		opts := options.FindOneAndUpdate().
+			SetProjection(projection).
+			SetUpsert(true)
+
+		if _, err := database.LeaseFindOneAndUpdate(ctx, db, filter, update, opts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// NewLeasePaymentAdd adds a payout of the lease to its totals, a lease is paid
+// once per hour until it ends.
+func NewLeasePaymentAdd(
+	db *mongo.Database,
+	id uint64, payment, stakingReward *types.Coin,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"id": id,
+		}
+		projection := bson.M{
+			"_id":            0,
+			"payment":        1,
+			"staking_reward": 1,
+		}
+		findOneOpts := options.FindOne().
+			SetProjection(projection)
+
+		item, err := database.LeaseFindOne(ctx, db, filter, findOneOpts)
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			item = &models.Lease{}
+		}
+
+		update := bson.M{
+			"$set": bson.M{
+				"payment":        addCoin(item.Payment, payment),
+				"staking_reward": addCoin(item.StakingReward, stakingReward),
+			},
+		}
+		projection = bson.M{
+			"_id": 1,
+		}
+		opts := options.FindOneAndUpdate().
+			SetProjection(projection).
+			SetUpsert(true)
+
+		if _, err := database.LeaseFindOneAndUpdate(ctx, db, filter, update, opts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func NewLeaseUpdateStatus(
+	db *mongo.Database,
+	id uint64, status string, height int64, timestamp time.Time, txHash string,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"id": id,
+		}
+
+		updateSet := bson.M{
+			"status":           status,
+			"status_height":    height,
+			"status_timestamp": timestamp,
+			"status_tx_hash":   txHash,
+		}
+		if status == hubtypes.StatusInactive.String() {
+			updateSet["end_height"] = height
+			updateSet["end_timestamp"] = timestamp
+			updateSet["end_tx_hash"] = txHash
+		}
+
+		update := bson.M{
+			"$set": updateSet,
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+		opts := options.FindOneAndUpdate().
+			SetProjection(projection).
+			SetUpsert(true)
+
+		if _, err := database.LeaseFindOneAndUpdate(ctx, db, filter, update, opts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+// addCoin returns the sum of the coins, either of which may be nil.
+func addCoin(a, b *types.Coin) *types.Coin {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	return a.Copy().Add(b.Amount)
+}
@@ -0,0 +1,137 @@
+package operations
+
+import (
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+	govtypes "github.com/sentinel-official/explorer/types/gov"
+)
+
+func NewProposalCreate(
+	db *mongo.Database,
+	v *models.Proposal,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		if _, err := database.ProposalInsertOne(ctx, db, v); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func NewProposalDepositAdd(
+	db *mongo.Database,
+	id uint64, coins types.Coins,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"id": id,
+		}
+		projection := bson.M{
+			"_id":           0,
+			"total_deposit": 1,
+		}
+		findOneOpts := options.FindOne().
+			SetProjection(projection)
+
+		item, err := database.ProposalFindOne(ctx, db, filter, findOneOpts)
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			return fmt.Errorf("proposal %d does not exist", id)
+		}
+
+		update := bson.M{
+			"$set": bson.M{
+				"total_deposit": item.TotalDeposit.Add(coins...),
+			},
+		}
+		projection = bson.M{
+			"_id": 1,
+		}
+		opts := options.FindOneAndUpdate().
+			SetProjection(projection)
+
+		if _, err := database.ProposalFindOneAndUpdate(ctx, db, filter, update, opts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func NewProposalUpdateVotingStart(
+	db *mongo.Database,
+	id uint64, height int64, timestamp time.Time, txHash string,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"id": id,
+		}
+		update := bson.M{
+			"$set": bson.M{
+				"voting_start_height":    height,
+				"voting_start_timestamp": timestamp,
+				"voting_start_tx_hash":   txHash,
+				"status":                 govtypes.StatusVotingPeriod,
+				"status_height":          height,
+				"status_timestamp":       timestamp,
+			},
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+		opts := options.FindOneAndUpdate().
+			SetProjection(projection)
+
+		if _, err := database.ProposalFindOneAndUpdate(ctx, db, filter, update, opts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func NewProposalUpdateStatus(
+	db *mongo.Database,
+	id uint64, status string, tally *models.ProposalTallyResult, height int64, timestamp time.Time,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"id": id,
+		}
+
+		updateSet := bson.M{
+			"status":           status,
+			"status_height":    height,
+			"status_timestamp": timestamp,
+		}
+		if tally != nil {
+			updateSet["final_tally_result"] = tally
+		}
+
+		update := bson.M{
+			"$set": updateSet,
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+		opts := options.FindOneAndUpdate().
+			SetProjection(projection)
+
+		if _, err := database.ProposalFindOneAndUpdate(ctx, db, filter, update, opts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,40 @@
+package operations
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+// NewProposalVoteUpsert replaces the earlier vote of the voter on the proposal,
+// since only the latest one counts.
+func NewProposalVoteUpsert(
+	db *mongo.Database,
+	v *models.ProposalVote,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"proposal_id": v.ProposalID,
+			"voter":       v.Voter,
+		}
+		update := bson.M{
+			"$set": v,
+		}
+		projection := bson.M{
+			"_id": 1,
+		}
+		opts := options.FindOneAndUpdate().
+			SetProjection(projection).
+			SetUpsert(true)
+
+		if _, err := database.ProposalVoteFindOneAndUpdate(ctx, db, filter, update, opts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
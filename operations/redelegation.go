@@ -0,0 +1,56 @@
+package operations
+
+import (
+	"time"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+func NewRedelegationCreate(
+	db *mongo.Database,
+	v *models.Redelegation,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		if _, err := database.RedelegationInsertOne(ctx, db, v); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func NewRedelegationComplete(
+	db *mongo.Database,
+	accAddr, srcValAddr, dstValAddr string, height int64, timestamp time.Time,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"acc_addr":     accAddr,
+			"src_val_addr": srcValAddr,
+			"dst_val_addr": dstValAddr,
+			"completion_time": bson.M{
+				"$lte": timestamp,
+			},
+			"status": hubtypes.StatusActive.String(),
+		}
+		update := bson.M{
+			"$set": bson.M{
+				"end_height":    height,
+				"end_timestamp": timestamp,
+				"status":        hubtypes.StatusInactive.String(),
+			},
+		}
+
+		if _, err := database.RedelegationUpdateMany(ctx, db, filter, update); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,22 @@
+package operations
+
+import (
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+func NewRewardWithdrawalCreate(
+	db *mongo.Database,
+	v *models.RewardWithdrawal,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		if _, err := database.RewardWithdrawalInsertOne(ctx, db, v); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
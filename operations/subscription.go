@@ -61,7 +61,12 @@ func NewSubscriptionCreate(
 				return err
 			}
 
-			v.Price = item.Prices.Get(v.Payment.Denom).Copy()
+			// The v3 subscriptions carry their price, and are paid after
+			// they are created.
+			if v.Price == nil {
+				v.Price = item.Prices.Get(v.Payment.Denom).Copy()
+			}
+
 			v.InactiveAt = v.StartTimestamp.Add(time.Duration(item.Duration))
 		}
 
@@ -109,6 +114,53 @@ func NewSubscriptionUpdateDetails(
 	}
 }
 
+// NewSubscriptionPaymentAdd adds a payment of the subscription to its totals, a
+// v3 subscription is paid once it is created and on each renewal.
+func NewSubscriptionPaymentAdd(
+	db *mongo.Database,
+	id uint64, payment, stakingReward *types.Coin,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"id": id,
+		}
+		projection := bson.M{
+			"_id":            0,
+			"payment":        1,
+			"staking_reward": 1,
+		}
+		findOneOpts := options.FindOne().
+			SetProjection(projection)
+
+		item, err := database.SubscriptionFindOne(ctx, db, filter, findOneOpts)
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			item = &models.Subscription{}
+		}
+
+		update := bson.M{
+			"$set": bson.M{
+				"payment":        addCoin(item.Payment, payment),
+				"staking_reward": addCoin(item.StakingReward, stakingReward),
+			},
+		}
+		projection = bson.M{
+			"_id": 1,
+		}
+		opts := options.FindOneAndUpdate().
+			SetProjection(projection).
+			SetUpsert(true)
+
+		if _, err := database.SubscriptionFindOneAndUpdate(ctx, db, filter, update, opts); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
 func NewSubscriptionUpdateStatus(
 	db *mongo.Database,
 	id uint64, status string, height int64, timestamp time.Time, txHash string,
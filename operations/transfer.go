@@ -0,0 +1,22 @@
+package operations
+
+import (
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+func NewTransferCreate(
+	db *mongo.Database,
+	v *models.Transfer,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		if _, err := database.TransferInsertOne(ctx, db, v); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
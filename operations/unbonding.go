@@ -0,0 +1,55 @@
+package operations
+
+import (
+	"time"
+
+	hubtypes "github.com/sentinel-official/hub/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/sentinel-official/explorer/database"
+	"github.com/sentinel-official/explorer/models"
+	"github.com/sentinel-official/explorer/types"
+)
+
+func NewUnbondingCreate(
+	db *mongo.Database,
+	v *models.Unbonding,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		if _, err := database.UnbondingInsertOne(ctx, db, v); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func NewUnbondingComplete(
+	db *mongo.Database,
+	accAddr, valAddr string, height int64, timestamp time.Time,
+) types.DatabaseOperation {
+	return func(ctx mongo.SessionContext) error {
+		filter := bson.M{
+			"acc_addr": accAddr,
+			"val_addr": valAddr,
+			"completion_time": bson.M{
+				"$lte": timestamp,
+			},
+			"status": hubtypes.StatusActive.String(),
+		}
+		update := bson.M{
+			"$set": bson.M{
+				"end_height":    height,
+				"end_timestamp": timestamp,
+				"status":        hubtypes.StatusInactive.String(),
+			},
+		}
+
+		if _, err := database.UnbondingUpdateMany(ctx, db, filter, update); err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
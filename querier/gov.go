@@ -0,0 +1,50 @@
+package querier
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (q *Querier) QueryProposal(ctx context.Context, id uint64) (*govtypes.Proposal, error) {
+	var (
+		qc  = govtypes.NewQueryClient(q)
+		req = &govtypes.QueryProposalRequest{
+			ProposalId: id,
+		}
+	)
+
+	res, err := qc.Proposal(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Proposal, nil
+}
+
+// QueryProposals pages through the proposals with the status, requesting
+// limit items per page.
+func (q *Querier) QueryProposals(ctx context.Context, status govtypes.ProposalStatus, limit uint64) (items []govtypes.Proposal, err error) {
+	var (
+		qc  = govtypes.NewQueryClient(q)
+		key []byte
+	)
+
+	for {
+		req := &govtypes.QueryProposalsRequest{
+			ProposalStatus: status,
+			Pagination:     &query.PageRequest{Key: key, Limit: limit},
+		}
+
+		res, err := qc.Proposals(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, res.Proposals...)
+		if key = res.Pagination.GetNextKey(); len(key) == 0 {
+			return items, nil
+		}
+	}
+}
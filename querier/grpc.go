@@ -41,7 +41,7 @@ func (q *Querier) Invoke(ctx context.Context, method string, args, reply interfa
 		}
 	}
 
-	res, err := q.queryABCI(req)
+	res, err := q.queryABCI(ctx, req)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,31 @@
+package querier
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+)
+
+func (q *Querier) QueryDenomTraces(ctx context.Context, limit uint64) (items []transfertypes.DenomTrace, err error) {
+	var (
+		qc  = transfertypes.NewQueryClient(q)
+		key []byte
+	)
+
+	for {
+		req := &transfertypes.QueryDenomTracesRequest{
+			Pagination: &query.PageRequest{Key: key, Limit: limit},
+		}
+
+		res, err := qc.DenomTraces(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, res.DenomTraces...)
+		if key = res.Pagination.GetNextKey(); len(key) == 0 {
+			return items, nil
+		}
+	}
+}
@@ -1,64 +1,337 @@
 package querier
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"net"
+	"net/http"
+	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	abcitypes "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/libs/bytes"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/rpc/client"
 	tmhttp "github.com/tendermint/tendermint/rpc/client/http"
 	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	jsonrpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
-type Querier struct {
-	codectypes.InterfaceRegistry
-	*tmhttp.HTTP
+// ErrEndpointsExhausted is returned once every endpoint has failed a query for
+// the configured number of rounds.
+type ErrEndpointsExhausted struct {
+	Rounds int
+	Errs   []error
+}
+
+func (e *ErrEndpointsExhausted) Error() string {
+	s := make([]string, 0, len(e.Errs))
+	for _, err := range e.Errs {
+		s = append(s, err.Error())
+	}
+
+	return fmt.Sprintf("all endpoints failed after %d rounds: %s", e.Rounds, strings.Join(s, "; "))
+}
+
+func (e *ErrEndpointsExhausted) Unwrap() []error {
+	return e.Errs
+}
+
+// ErrStatus is returned for the responses with a server error status whose
+// body is not a JSON-RPC response, such as the error pages of a proxy.
+type ErrStatus struct {
+	Code int
+}
+
+func (e *ErrStatus) Error() string {
+	return fmt.Sprintf("unexpected status code %d", e.Code)
+}
+
+// statusTransport turns the server error responses without a JSON-RPC body into
+// errors. The node itself answers the failed queries, such as the ones at an
+// unavailable height, with a server error status and a JSON-RPC error.
+type statusTransport struct {
+	http.RoundTripper
 }
 
-func NewQuerier(ir codectypes.InterfaceRegistry, remote, wsEndpoint string) (q *Querier, err error) {
-	http, err := tmhttp.New(remote, wsEndpoint)
+func (t statusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.RoundTripper.RoundTrip(req)
 	if err != nil {
 		return nil, err
 	}
+	if resp.StatusCode < http.StatusInternalServerError && resp.StatusCode != http.StatusTooManyRequests {
+		return resp, nil
+	}
+
+	defer resp.Body.Close()
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var v struct {
+		Error json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(buf, &v); err != nil || len(v.Error) == 0 {
+		return nil, &ErrStatus{Code: resp.StatusCode}
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(buf))
+	return resp, nil
+}
+
+// isEndpointError reports whether the error is one of the endpoint, a transport
+// error or a server error status, rather than an answer of the node such as a
+// height which is not yet available or is pruned. Only the former is retried on
+// the other endpoints and puts the endpoint aside.
+func isEndpointError(err error) bool {
+	var (
+		statusErr *ErrStatus
+		urlErr    *url.Error
+		netErr    net.Error
+		syntaxErr *json.SyntaxError
+	)
+
+	switch {
+	case errors.As(err, &statusErr), errors.As(err, &urlErr), errors.As(err, &netErr):
+		return true
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return true
+	case errors.As(err, &syntaxErr):
+		// The body of the response is not JSON at all.
+		return true
+	default:
+		return false
+	}
+}
+
+type endpoint struct {
+	remote    string
+	http      *tmhttp.HTTP
+	latency   time.Duration
+	failures  int
+	downUntil time.Time
+}
+
+// Querier sends the RPC queries to a list of endpoints. A failing endpoint is
+// put aside with an exponential backoff and the query is retried on the next
+// healthy endpoint with the lowest latency.
+type Querier struct {
+	codectypes.InterfaceRegistry
+
+	mu         sync.Mutex
+	endpoints  []*endpoint
+	current    int
+	maxRounds  int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+func NewQuerier(ir codectypes.InterfaceRegistry, remotes []string, wsEndpoint string) (q *Querier, err error) {
+	if len(remotes) == 0 {
+		return nil, fmt.Errorf("at least one remote is required")
+	}
+
+	endpoints := make([]*endpoint, 0, len(remotes))
+	for _, remote := range remotes {
+		httpClient, err := jsonrpcclient.DefaultHTTPClient(remote)
+		if err != nil {
+			return nil, err
+		}
+
+		httpClient.Transport = statusTransport{RoundTripper: httpClient.Transport}
+
+		http, err := tmhttp.NewWithClient(remote, wsEndpoint, httpClient)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoints = append(endpoints, &endpoint{
+			remote: remote,
+			http:   http,
+		})
+	}
 
 	return &Querier{
 		InterfaceRegistry: ir,
-		HTTP:              http,
+		endpoints:         endpoints,
+		maxRounds:         3,
+		minBackoff:        time.Second,
+		maxBackoff:        30 * time.Second,
 	}, nil
 }
 
-func (q *Querier) queryABCI(req *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error) {
-	opts := client.ABCIQueryOptions{
-		Height: req.GetHeight(),
-		Prove:  req.Prove,
+func (q *Querier) WithMaxRounds(v int) *Querier {
+	q.maxRounds = v
+	return q
+}
+
+func (q *Querier) WithBackoff(min, max time.Duration) *Querier {
+	q.minBackoff, q.maxBackoff = min, max
+	return q
+}
+
+// Client returns the client of the current endpoint, which is meant for the
+// websocket subscriptions.
+func (q *Querier) Client() *tmhttp.HTTP {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.endpoints[q.current].http
+}
+
+func (q *Querier) backoff(failures int) time.Duration {
+	d := q.minBackoff
+	for i := 1; i < failures && d < q.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > q.maxBackoff {
+		d = q.maxBackoff
+	}
+
+	return d
+}
+
+// pick returns the endpoint to be tried next, skipping the ones already tried
+// in the current round. The current endpoint is kept while it is healthy.
+func (q *Querier) pick(tried map[int]bool) (int, *endpoint) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	if !tried[q.current] && !q.endpoints[q.current].downUntil.After(now) {
+		return q.current, q.endpoints[q.current]
+	}
+
+	index := -1
+	for i, e := range q.endpoints {
+		if tried[i] || e.downUntil.After(now) {
+			continue
+		}
+		if index == -1 || e.latency < q.endpoints[index].latency {
+			index = i
+		}
+	}
+
+	// Every untried endpoint is backing off, the one which recovers first is used.
+	if index == -1 {
+		for i, e := range q.endpoints {
+			if tried[i] {
+				continue
+			}
+			if index == -1 || e.downUntil.Before(q.endpoints[index].downUntil) {
+				index = i
+			}
+		}
 	}
 
-	result, err := q.ABCIQueryWithOptions(context.TODO(), req.Path, req.Data, opts)
-	log.Println("ABCIQueryWithOptions", err)
+	if index != q.current {
+		log.Println("Endpoint", q.endpoints[q.current].remote, "->", q.endpoints[index].remote)
+		q.current = index
+	}
+
+	return index, q.endpoints[index]
+}
+
+func (q *Querier) report(e *endpoint, latency time.Duration, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
 	if err != nil {
-		if strings.Contains(err.Error(), "EOF") {
-			return q.queryABCI(req)
+		e.failures++
+		e.downUntil = time.Now().Add(q.backoff(e.failures))
+		return
+	}
+
+	if e.latency == 0 {
+		e.latency = latency
+	} else {
+		e.latency = (4*e.latency + latency) / 5
+	}
+
+	e.failures = 0
+	e.downUntil = time.Time{}
+}
+
+// do runs fn against the endpoints until it succeeds, the context is done or
+// every endpoint has failed for maxRounds rounds. An error which is not one of
+// the endpoint is returned as is, without trying the other endpoints.
+func (q *Querier) do(ctx context.Context, fn func(ctx context.Context, c *tmhttp.HTTP) error) error {
+	var errs []error
+	for round := 0; round < q.maxRounds; round++ {
+		tried := make(map[int]bool)
+		for len(tried) < len(q.endpoints) {
+			index, e := q.pick(tried)
+			tried[index] = true
+
+			now := time.Now()
+			err := fn(ctx, e.http)
+			if err == nil {
+				q.report(e, time.Since(now), nil)
+				return nil
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if !isEndpointError(err) {
+				q.report(e, time.Since(now), nil)
+				return err
+			}
+
+			log.Println("Endpoint", e.remote, err)
+			q.report(e, 0, err)
+			errs = append(errs, fmt.Errorf("%s: %w", e.remote, err))
 		}
-		if strings.Contains(err.Error(), "invalid character '<' looking for beginning of value") {
-			return q.queryABCI(req)
+
+		if round+1 == q.maxRounds {
+			break
 		}
 
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(q.backoff(round + 1)):
+		}
+	}
+
+	return &ErrEndpointsExhausted{
+		Rounds: q.maxRounds,
+		Errs:   errs,
+	}
+}
+
+func (q *Querier) queryABCI(ctx context.Context, req *abcitypes.RequestQuery) (*abcitypes.ResponseQuery, error) {
+	opts := client.ABCIQueryOptions{
+		Height: req.GetHeight(),
+		Prove:  req.Prove,
+	}
+
+	var result *coretypes.ResultABCIQuery
+	err := q.do(ctx, func(ctx context.Context, c *tmhttp.HTTP) (err error) {
+		result, err = c.ABCIQueryWithOptions(ctx, req.Path, req.Data, opts)
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	if !result.Response.IsOK() {
-		return nil, fmt.Errorf(result.Response.Log)
+		return nil, errors.New(result.Response.Log)
 	}
 
 	return &result.Response, nil
 }
 
-func (q *Querier) queryKey(store string, data bytes.HexBytes, height int64) ([]byte, error) {
+func (q *Querier) queryKey(ctx context.Context, store string, data tmbytes.HexBytes, height int64) ([]byte, error) {
 	req := &abcitypes.RequestQuery{
 		Data:   data,
 		Path:   fmt.Sprintf("/store/%s/key", store),
@@ -66,7 +339,7 @@ func (q *Querier) queryKey(store string, data bytes.HexBytes, height int64) ([]b
 		Prove:  false,
 	}
 
-	res, err := q.queryABCI(req)
+	res, err := q.queryABCI(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -74,20 +347,65 @@ func (q *Querier) queryKey(store string, data bytes.HexBytes, height int64) ([]b
 	return res.Value, nil
 }
 
-func (q *Querier) QueryBlock(ctx context.Context, height int64) (*coretypes.ResultBlock, error) {
+func (q *Querier) QueryBlock(ctx context.Context, height int64) (result *coretypes.ResultBlock, err error) {
 	now := time.Now()
 	defer func() {
 		log.Println("QueryBlock", height, time.Since(now))
 	}()
 
-	return q.Block(ctx, &height)
+	err = q.do(ctx, func(ctx context.Context, c *tmhttp.HTTP) (err error) {
+		result, err = c.Block(ctx, &height)
+		return err
+	})
+
+	return result, err
 }
 
-func (q *Querier) QueryBlockResults(ctx context.Context, height int64) (*coretypes.ResultBlockResults, error) {
+func (q *Querier) QueryBlockResults(ctx context.Context, height int64) (result *coretypes.ResultBlockResults, err error) {
 	now := time.Now()
 	defer func() {
 		log.Println("QueryBlockResults", height, time.Since(now))
 	}()
 
-	return q.BlockResults(ctx, &height)
+	err = q.do(ctx, func(ctx context.Context, c *tmhttp.HTTP) (err error) {
+		result, err = c.BlockResults(ctx, &height)
+		return err
+	})
+
+	return result, err
+}
+
+func (q *Querier) QueryStatus(ctx context.Context) (result *coretypes.ResultStatus, err error) {
+	err = q.do(ctx, func(ctx context.Context, c *tmhttp.HTTP) (err error) {
+		result, err = c.Status(ctx)
+		return err
+	})
+
+	return result, err
+}
+
+// QueryValidators returns the validator set at the height, in the order of the
+// commit signatures of the block.
+func (q *Querier) QueryValidators(ctx context.Context, height int64) (items []*tmtypes.Validator, err error) {
+	now := time.Now()
+	defer func() {
+		log.Println("QueryValidators", height, time.Since(now))
+	}()
+
+	perPage := 100
+	for page := 1; ; page++ {
+		var result *coretypes.ResultValidators
+		err = q.do(ctx, func(ctx context.Context, c *tmhttp.HTTP) (err error) {
+			result, err = c.Validators(ctx, &height, &page, &perPage)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, result.Validators...)
+		if len(result.Validators) == 0 || len(items) >= result.Total {
+			return items, nil
+		}
+	}
 }
@@ -0,0 +1,90 @@
+package querier
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+)
+
+// newTestServer returns a server answering every request with the status and
+// the body, and counting the requests.
+func newTestServer(t *testing.T, status int, body string) (*httptest.Server, *int32) {
+	var count int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&count, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &count
+}
+
+func newTestQuerier(t *testing.T, remotes ...string) *Querier {
+	q, err := NewQuerier(nil, remotes, "/websocket")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return q.WithMaxRounds(1).WithBackoff(time.Millisecond, time.Millisecond)
+}
+
+func TestQuerierNodeError(t *testing.T) {
+	body := `{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error","data":"height 10 must be less than or equal to the current blockchain height 9"}}`
+	srv1, count1 := newTestServer(t, http.StatusInternalServerError, body)
+	srv2, count2 := newTestServer(t, http.StatusInternalServerError, body)
+
+	q := newTestQuerier(t, srv1.URL, srv2.URL)
+
+	_, err := q.QueryBlock(context.Background(), 10)
+
+	var rpcErr *rpctypes.RPCError
+	if !errors.As(err, &rpcErr) {
+		t.Fatalf("got error %v, want an RPC error", err)
+	}
+	if *count1 != 1 || *count2 != 0 {
+		t.Fatalf("got %d and %d requests, want the node error not to fail over", *count1, *count2)
+	}
+	if !q.endpoints[0].downUntil.IsZero() {
+		t.Fatal("expected the endpoint not to be put aside")
+	}
+}
+
+func TestQuerierEndpointError(t *testing.T) {
+	srv1, count1 := newTestServer(t, http.StatusBadGateway, "<html>Bad Gateway</html>")
+	srv2, count2 := newTestServer(t, http.StatusInternalServerError, `{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error"}}`)
+
+	q := newTestQuerier(t, srv1.URL, srv2.URL)
+
+	_, err := q.QueryBlock(context.Background(), 10)
+
+	var rpcErr *rpctypes.RPCError
+	if !errors.As(err, &rpcErr) {
+		t.Fatalf("got error %v, want the RPC error of the second endpoint", err)
+	}
+	if *count1 != 1 || *count2 != 1 {
+		t.Fatalf("got %d and %d requests, want the server error to fail over", *count1, *count2)
+	}
+	if q.endpoints[0].downUntil.IsZero() {
+		t.Fatal("expected the failing endpoint to be put aside")
+	}
+}
+
+func TestIsEndpointError(t *testing.T) {
+	if isEndpointError(&rpctypes.RPCError{Code: -32603}) {
+		t.Fatal("expected an RPC error not to be an endpoint error")
+	}
+	if !isEndpointError(&ErrStatus{Code: http.StatusServiceUnavailable}) {
+		t.Fatal("expected a status error to be an endpoint error")
+	}
+	if isEndpointError(errors.New("codespace sdk code 18: invalid request")) {
+		t.Fatal("expected a query error not to be an endpoint error")
+	}
+}
@@ -0,0 +1,59 @@
+package querier
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// QueryStakingValidators pages through the validators of the staking module,
+// whatever their status, requesting limit items per page.
+func (q *Querier) QueryStakingValidators(ctx context.Context, limit uint64) (items []stakingtypes.Validator, err error) {
+	var (
+		qc  = stakingtypes.NewQueryClient(q)
+		key []byte
+	)
+
+	for {
+		req := &stakingtypes.QueryValidatorsRequest{
+			Pagination: &query.PageRequest{Key: key, Limit: limit},
+		}
+
+		res, err := qc.Validators(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, res.Validators...)
+		if key = res.Pagination.GetNextKey(); len(key) == 0 {
+			return items, nil
+		}
+	}
+}
+
+// QueryDelegatorDelegations pages through the delegations of the delegator,
+// requesting limit items per page.
+func (q *Querier) QueryDelegatorDelegations(ctx context.Context, accAddr string, limit uint64) (items []stakingtypes.DelegationResponse, err error) {
+	var (
+		qc  = stakingtypes.NewQueryClient(q)
+		key []byte
+	)
+
+	for {
+		req := &stakingtypes.QueryDelegatorDelegationsRequest{
+			DelegatorAddr: accAddr,
+			Pagination:    &query.PageRequest{Key: key, Limit: limit},
+		}
+
+		res, err := qc.DelegatorDelegations(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, res.DelegationResponses...)
+		if key = res.Pagination.GetNextKey(); len(key) == 0 {
+			return items, nil
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package querier
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	deposittypes "github.com/sentinel-official/hub/x/deposit/types"
+	nodetypes "github.com/sentinel-official/hub/x/node/types"
+	plantypes "github.com/sentinel-official/hub/x/plan/types"
+	providertypes "github.com/sentinel-official/hub/x/provider/types"
+	sessiontypes "github.com/sentinel-official/hub/x/session/types"
+	subscriptiontypes "github.com/sentinel-official/hub/x/subscription/types"
+	"google.golang.org/grpc/metadata"
+)
+
+// ContextWithHeight returns a context which makes the gRPC queries against the
+// state at the height.
+func ContextWithHeight(ctx context.Context, height int64) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+}
+
+// The methods below page through the listings of the VPN modules, requesting
+// limit items per page until the next key is empty.
+
+func (q *Querier) QueryDeposits(ctx context.Context, limit uint64) (items []deposittypes.Deposit, err error) {
+	var (
+		qc  = deposittypes.NewQueryServiceClient(q)
+		key []byte
+	)
+
+	for {
+		req := &deposittypes.QueryDepositsRequest{
+			Pagination: &query.PageRequest{Key: key, Limit: limit},
+		}
+
+		res, err := qc.QueryDeposits(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, res.Deposits...)
+		if key = res.Pagination.GetNextKey(); len(key) == 0 {
+			return items, nil
+		}
+	}
+}
+
+func (q *Querier) QueryNodes(ctx context.Context, limit uint64) (items []nodetypes.Node, err error) {
+	var (
+		qc  = nodetypes.NewQueryServiceClient(q)
+		key []byte
+	)
+
+	for {
+		req := &nodetypes.QueryNodesRequest{
+			Pagination: &query.PageRequest{Key: key, Limit: limit},
+		}
+
+		res, err := qc.QueryNodes(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, res.Nodes...)
+		if key = res.Pagination.GetNextKey(); len(key) == 0 {
+			return items, nil
+		}
+	}
+}
+
+func (q *Querier) QueryNodesForPlan(ctx context.Context, id, limit uint64) (items []nodetypes.Node, err error) {
+	var (
+		qc  = nodetypes.NewQueryServiceClient(q)
+		key []byte
+	)
+
+	for {
+		req := &nodetypes.QueryNodesForPlanRequest{
+			Id:         id,
+			Pagination: &query.PageRequest{Key: key, Limit: limit},
+		}
+
+		res, err := qc.QueryNodesForPlan(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, res.Nodes...)
+		if key = res.Pagination.GetNextKey(); len(key) == 0 {
+			return items, nil
+		}
+	}
+}
+
+func (q *Querier) QueryPlans(ctx context.Context, limit uint64) (items []plantypes.Plan, err error) {
+	var (
+		qc  = plantypes.NewQueryServiceClient(q)
+		key []byte
+	)
+
+	for {
+		req := &plantypes.QueryPlansRequest{
+			Pagination: &query.PageRequest{Key: key, Limit: limit},
+		}
+
+		res, err := qc.QueryPlans(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, res.Plans...)
+		if key = res.Pagination.GetNextKey(); len(key) == 0 {
+			return items, nil
+		}
+	}
+}
+
+func (q *Querier) QueryProviders(ctx context.Context, limit uint64) (items []providertypes.Provider, err error) {
+	var (
+		qc  = providertypes.NewQueryServiceClient(q)
+		key []byte
+	)
+
+	for {
+		req := &providertypes.QueryProvidersRequest{
+			Pagination: &query.PageRequest{Key: key, Limit: limit},
+		}
+
+		res, err := qc.QueryProviders(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, res.Providers...)
+		if key = res.Pagination.GetNextKey(); len(key) == 0 {
+			return items, nil
+		}
+	}
+}
+
+func (q *Querier) QuerySessions(ctx context.Context, limit uint64) (items []sessiontypes.Session, err error) {
+	var (
+		qc  = sessiontypes.NewQueryServiceClient(q)
+		key []byte
+	)
+
+	for {
+		req := &sessiontypes.QuerySessionsRequest{
+			Pagination: &query.PageRequest{Key: key, Limit: limit},
+		}
+
+		res, err := qc.QuerySessions(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, res.Sessions...)
+		if key = res.Pagination.GetNextKey(); len(key) == 0 {
+			return items, nil
+		}
+	}
+}
+
+func (q *Querier) QuerySubscriptions(ctx context.Context, limit uint64) (items []subscriptiontypes.Subscription, err error) {
+	var (
+		qc  = subscriptiontypes.NewQueryServiceClient(q)
+		key []byte
+	)
+
+	for {
+		req := &subscriptiontypes.QuerySubscriptionsRequest{
+			Pagination: &query.PageRequest{Key: key, Limit: limit},
+		}
+
+		res, err := qc.QuerySubscriptions(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range res.Subscriptions {
+			item, ok := v.GetCachedValue().(subscriptiontypes.Subscription)
+			if !ok {
+				return nil, fmt.Errorf("invalid subscription type %s", v.TypeUrl)
+			}
+
+			items = append(items, item)
+		}
+
+		if key = res.Pagination.GetNextKey(); len(key) == 0 {
+			return items, nil
+		}
+	}
+}
+
+func (q *Querier) QueryAllocations(ctx context.Context, id, limit uint64) (items []subscriptiontypes.Allocation, err error) {
+	var (
+		qc  = subscriptiontypes.NewQueryServiceClient(q)
+		key []byte
+	)
+
+	for {
+		req := &subscriptiontypes.QueryAllocationsRequest{
+			Id:         id,
+			Pagination: &query.PageRequest{Key: key, Limit: limit},
+		}
+
+		res, err := qc.QueryAllocations(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, res.Allocations...)
+		if key = res.Pagination.GetNextKey(); len(key) == 0 {
+			return items, nil
+		}
+	}
+}
@@ -0,0 +1,16 @@
+#!/bin/bash
+
+set -e
+
+proto_dir="./proto"
+third_party_dir="./third_party/proto"
+module="github.com/sentinel-official/explorer"
+
+protoc \
+  -I "$proto_dir" \
+  -I "$third_party_dir" \
+  --go_out=. \
+  --go_opt=module="$module" \
+  --go-grpc_out=. \
+  --go-grpc_opt=module="$module" \
+  $(find "$proto_dir" -name "*.proto")
@@ -15,12 +15,27 @@ RestartSec=5s
 [Install]
 WantedBy=multi-user.target"
 
+# The commands which run once and exit are linked but not run as services,
+# since a service would restart them in a loop.
+oneshot_apps=(
+  "08_rollback"
+  "09_tx-index"
+  "10_reconcile"
+  "11_bootstrap"
+  "12_migrate"
+)
+
 for app_name in "$app_directory"/*; do
   app_name=$(basename "$app_name")
   service_file="$systemd_dir/${app_name}.service"
   modified_template="${app_template//APP_NAME/$app_name}"
 
   ln -fs "${GOPATH}/bin/${app_name}" "/usr/local/bin/${app_name}"
+  if [[ " ${oneshot_apps[*]} " == *" ${app_name} "* ]]; then
+    echo "Skipped ${app_name}, it runs once"
+    continue
+  fi
+
   if echo "$modified_template" > "$service_file"; then
     echo "Created $service_file"
   fi
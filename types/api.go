@@ -12,10 +12,17 @@ func NewError(code int, message string) *Error {
 	}
 }
 
+type Pagination struct {
+	Total int64  `json:"total"`
+	Next  string `json:"next,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+}
+
 type Response struct {
-	Success bool        `json:"success"`
-	Error   *Error      `json:"error,omitempty"`
-	Result  interface{} `json:"result,omitempty"`
+	Success    bool        `json:"success"`
+	Error      *Error      `json:"error,omitempty"`
+	Result     interface{} `json:"result,omitempty"`
+	Pagination *Pagination `json:"pagination,omitempty"`
 }
 
 func NewResponse(err *Error, res interface{}) *Response {
@@ -39,3 +46,8 @@ func NewResponseResult(v interface{}) *Response {
 		Result:  v,
 	}
 }
+
+func (r *Response) WithPagination(v *Pagination) *Response {
+	r.Pagination = v
+	return r
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// price is the JSON form of either a coin or a v3 hub price, the quote value
+// of a price is the amount of the denom charged per unit.
+type price struct {
+	Denom      string `json:"denom"`
+	Amount     string `json:"amount"`
+	BaseValue  string `json:"base_value"`
+	QuoteValue string `json:"quote_value"`
+}
+
+// ParseCoinsAttribute parses the coins of an event attribute. The v2 events
+// carry a coins string while the v3 typed events carry a coin, a price or a
+// JSON list of either coin strings, coins or prices.
+func ParseCoinsAttribute(s string) (Coins, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "null" {
+		return Coins{}, nil
+	}
+	if strings.HasPrefix(s, "{") {
+		s = "[" + s + "]"
+	}
+	if !strings.HasPrefix(s, "[") {
+		coins, err := sdk.ParseCoinsNormalized(s)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewCoins(coins), nil
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal([]byte(s), &items); err != nil {
+		return nil, err
+	}
+
+	var coins sdk.Coins
+	for _, item := range items {
+		var v string
+		if err := json.Unmarshal(item, &v); err == nil {
+			coin, err := sdk.ParseCoinNormalized(v)
+			if err != nil {
+				return nil, err
+			}
+
+			coins = coins.Add(coin)
+			continue
+		}
+
+		var p price
+		if err := json.Unmarshal(item, &p); err != nil {
+			return nil, err
+		}
+
+		value := p.QuoteValue
+		if value == "" {
+			value = p.Amount
+		}
+
+		amount, ok := sdk.NewIntFromString(value)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %s", value)
+		}
+
+		coins = coins.Add(sdk.NewCoin(p.Denom, amount))
+	}
+
+	return NewCoins(coins), nil
+}
+
+// ParseCoinAttribute parses an event attribute holding a single coin in any of
+// the forms of ParseCoinsAttribute, nil is returned for an empty attribute.
+func ParseCoinAttribute(s string) (*Coin, error) {
+	coins, err := ParseCoinsAttribute(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(coins) == 0 {
+		return nil, nil
+	}
+
+	return coins[0], nil
+}
+
+// ParseStringsAttribute parses an event attribute holding either a single
+// string or a JSON list of strings.
+func ParseStringsAttribute(s string) ([]string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "null" {
+		return []string{}, nil
+	}
+	if !strings.HasPrefix(s, "[") {
+		return []string{s}, nil
+	}
+
+	var items []string
+	if err := json.Unmarshal([]byte(s), &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
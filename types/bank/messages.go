@@ -0,0 +1,93 @@
+package bank
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+type MsgSend struct {
+	FromAddress string
+	ToAddress   string
+	Amount      types.Coins
+}
+
+func NewMsgSend(v bson.M) (*MsgSend, error) {
+	buf, err := json.Marshal(v["amount"])
+	if err != nil {
+		return nil, err
+	}
+
+	var amount sdk.Coins
+	if err := json.Unmarshal(buf, &amount); err != nil {
+		return nil, err
+	}
+
+	return &MsgSend{
+		FromAddress: v["from_address"].(string),
+		ToAddress:   v["to_address"].(string),
+		Amount:      types.NewCoins(amount),
+	}, nil
+}
+
+type Input struct {
+	Address string
+	Coins   types.Coins
+}
+
+type Output struct {
+	Address string
+	Coins   types.Coins
+}
+
+type MsgMultiSend struct {
+	Inputs  []*Input
+	Outputs []*Output
+}
+
+func NewMsgMultiSend(v bson.M) (*MsgMultiSend, error) {
+	buf, err := json.Marshal(v["inputs"])
+	if err != nil {
+		return nil, err
+	}
+
+	var inputs []struct {
+		Address string    `json:"address"`
+		Coins   sdk.Coins `json:"coins"`
+	}
+	if err := json.Unmarshal(buf, &inputs); err != nil {
+		return nil, err
+	}
+
+	buf, err = json.Marshal(v["outputs"])
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs []struct {
+		Address string    `json:"address"`
+		Coins   sdk.Coins `json:"coins"`
+	}
+	if err := json.Unmarshal(buf, &outputs); err != nil {
+		return nil, err
+	}
+
+	msg := &MsgMultiSend{}
+	for _, item := range inputs {
+		msg.Inputs = append(msg.Inputs, &Input{
+			Address: item.Address,
+			Coins:   types.NewCoins(item.Coins),
+		})
+	}
+	for _, item := range outputs {
+		msg.Outputs = append(msg.Outputs, &Output{
+			Address: item.Address,
+			Coins:   types.NewCoins(item.Coins),
+		})
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,86 @@
+package coingecko
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type Point struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+type MarketChart struct {
+	Prices []*Point
+}
+
+func (mc *MarketChart) UnmarshalJSON(data []byte) error {
+	var v struct {
+		Prices [][2]float64 `json:"prices"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	mc.Prices = make([]*Point, 0, len(v.Prices))
+	for _, item := range v.Prices {
+		mc.Prices = append(
+			mc.Prices,
+			&Point{
+				Timestamp: time.UnixMilli(int64(item[0])).UTC(),
+				Value:     item[1],
+			},
+		)
+	}
+
+	return nil
+}
+
+// FetchMarketChartRange queries the /coins/{id}/market_chart/range endpoint. The granularity of the
+// returned prices depends on the width of the range: 5-minutely within a day, hourly up to 90 days
+// and daily beyond that.
+func FetchMarketChartRange(apiURL, apiKeyHeader, apiKey, coinID, currency string, from, to time.Time, timeout time.Duration) (*MarketChart, error) {
+	urlPath, err := url.JoinPath(apiURL, "coins", coinID, "market_chart", "range")
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("vs_currency", currency)
+	query.Set("from", strconv.FormatInt(from.Unix(), 10))
+	query.Set("to", strconv.FormatInt(to.Unix(), 10))
+
+	req, err := http.NewRequest(http.MethodGet, urlPath+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if apiKey != "" {
+		req.Header.Set(apiKeyHeader, apiKey)
+	}
+
+	client := &http.Client{
+		Timeout: timeout,
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var v MarketChart
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
@@ -0,0 +1,67 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+type EventWithdrawRewards struct {
+	Validator string
+	Amount    types.Coins
+}
+
+func NewEventWithdrawRewards(v *types.Event) (*EventWithdrawRewards, error) {
+	amount, err := sdk.ParseCoinsNormalized(v.Attributes["amount"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventWithdrawRewards{
+		Validator: v.Attributes["validator"],
+		Amount:    types.NewCoins(amount),
+	}, nil
+}
+
+func NewEventWithdrawRewardsFromEvents(v types.Events, skip int) (int, *EventWithdrawRewards, error) {
+	i, e, err := v.Get("withdraw_rewards", skip)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	item, err := NewEventWithdrawRewards(e)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return i, item, nil
+}
+
+type EventWithdrawCommission struct {
+	Amount types.Coins
+}
+
+func NewEventWithdrawCommission(v *types.Event) (*EventWithdrawCommission, error) {
+	amount, err := sdk.ParseCoinsNormalized(v.Attributes["amount"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventWithdrawCommission{
+		Amount: types.NewCoins(amount),
+	}, nil
+}
+
+func NewEventWithdrawCommissionFromEvents(v types.Events, skip int) (int, *EventWithdrawCommission, error) {
+	i, e, err := v.Get("withdraw_commission", skip)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	item, err := NewEventWithdrawCommission(e)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return i, item, nil
+}
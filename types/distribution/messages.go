@@ -0,0 +1,27 @@
+package distribution
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type MsgWithdrawDelegatorReward struct {
+	DelegatorAddress string
+	ValidatorAddress string
+}
+
+func NewMsgWithdrawDelegatorReward(v bson.M) (*MsgWithdrawDelegatorReward, error) {
+	return &MsgWithdrawDelegatorReward{
+		DelegatorAddress: v["delegator_address"].(string),
+		ValidatorAddress: v["validator_address"].(string),
+	}, nil
+}
+
+type MsgWithdrawValidatorCommission struct {
+	ValidatorAddress string
+}
+
+func NewMsgWithdrawValidatorCommission(v bson.M) (*MsgWithdrawValidatorCommission, error) {
+	return &MsgWithdrawValidatorCommission{
+		ValidatorAddress: v["validator_address"].(string),
+	}, nil
+}
@@ -0,0 +1,164 @@
+package types
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	DepositChangeTypeAdd      = "add"
+	DepositChangeTypeSubtract = "subtract"
+)
+
+type DepositChange struct {
+	Type    string `json:"type,omitempty" bson:"type"`
+	AccAddr string `json:"acc_addr,omitempty" bson:"acc_addr"`
+	Coins   Coins  `json:"coins,omitempty" bson:"coins"`
+}
+
+// Entities are the hub entities referenced by a set of events.
+type Entities struct {
+	AccAddrs        []string         `json:"acc_addrs,omitempty" bson:"acc_addrs"`
+	Deposits        []*DepositChange `json:"deposits,omitempty" bson:"deposits"`
+	NodeAddrs       []string         `json:"node_addrs,omitempty" bson:"node_addrs"`
+	PlanIDs         []uint64         `json:"plan_ids,omitempty" bson:"plan_ids"`
+	ProvAddrs       []string         `json:"prov_addrs,omitempty" bson:"prov_addrs"`
+	SessionIDs      []uint64         `json:"session_ids,omitempty" bson:"session_ids"`
+	SubscriptionIDs []uint64         `json:"subscription_ids,omitempty" bson:"subscription_ids"`
+}
+
+// NewEntitiesFromEvents collects the entities from the attributes of the hub
+// events. The meaning of the id and address attributes depends on the module
+// which emitted the event, the attributes of other events are ignored.
+func NewEntitiesFromEvents(v Events) *Entities {
+	item := &Entities{}
+	for _, e := range v {
+		parts := strings.Split(e.Type, ".")
+		if len(parts) < 4 || parts[0] != "sentinel" {
+			continue
+		}
+
+		keys := make([]string, 0, len(e.Attributes))
+		for key := range e.Attributes {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		module := parts[1]
+		for _, key := range keys {
+			value := strings.Trim(e.Attributes[key], `"`)
+			if value == "" {
+				continue
+			}
+
+			switch key {
+			case "id":
+				switch module {
+				case "plan":
+					item.addPlanID(value)
+				case "session":
+					item.addSessionID(value)
+				case "subscription":
+					item.addSubscriptionID(value)
+				}
+			case "address":
+				switch module {
+				case "deposit", "session", "subscription":
+					item.AccAddrs = appendUnique(item.AccAddrs, value)
+				case "node":
+					item.NodeAddrs = appendUnique(item.NodeAddrs, value)
+				case "plan", "provider":
+					item.ProvAddrs = appendUnique(item.ProvAddrs, value)
+				}
+			case "acc_address":
+				item.AccAddrs = appendUnique(item.AccAddrs, value)
+			case "node_address":
+				item.NodeAddrs = appendUnique(item.NodeAddrs, value)
+			case "prov_address":
+				item.ProvAddrs = appendUnique(item.ProvAddrs, value)
+			case "plan_id":
+				item.addPlanID(value)
+			case "session_id":
+				item.addSessionID(value)
+			case "subscription_id":
+				item.addSubscriptionID(value)
+			}
+		}
+
+		if module == "deposit" {
+			item.addDeposit(parts[len(parts)-1], e)
+		}
+	}
+
+	return item
+}
+
+func (e *Entities) addPlanID(s string) {
+	if id, err := strconv.ParseUint(s, 10, 64); err == nil && id != 0 {
+		e.PlanIDs = appendUnique(e.PlanIDs, id)
+	}
+}
+
+func (e *Entities) addSessionID(s string) {
+	if id, err := strconv.ParseUint(s, 10, 64); err == nil && id != 0 {
+		e.SessionIDs = appendUnique(e.SessionIDs, id)
+	}
+}
+
+func (e *Entities) addSubscriptionID(s string) {
+	if id, err := strconv.ParseUint(s, 10, 64); err == nil && id != 0 {
+		e.SubscriptionIDs = appendUnique(e.SubscriptionIDs, id)
+	}
+}
+
+func (e *Entities) addDeposit(name string, v *Event) {
+	var t string
+	switch name {
+	case "EventAdd":
+		t = DepositChangeTypeAdd
+	case "EventSubtract":
+		t = DepositChangeTypeSubtract
+	default:
+		return
+	}
+
+	coins, err := ParseCoinsAttribute(strings.Trim(v.Attributes["coins"], `"`))
+	if err != nil {
+		return
+	}
+
+	e.Deposits = append(e.Deposits, &DepositChange{
+		Type:    t,
+		AccAddr: strings.Trim(v.Attributes["address"], `"`),
+		Coins:   coins,
+	})
+}
+
+// HasAccAddr reports whether the address is one of the accounts.
+func (e *Entities) HasAccAddr(s string) bool { return slices.Contains(e.AccAddrs, s) }
+
+// HasNodeAddr reports whether the address is one of the nodes.
+func (e *Entities) HasNodeAddr(s string) bool { return slices.Contains(e.NodeAddrs, s) }
+
+// HasPlanID reports whether the id is one of the plans.
+func (e *Entities) HasPlanID(id uint64) bool { return slices.Contains(e.PlanIDs, id) }
+
+// HasProvAddr reports whether the address is one of the providers.
+func (e *Entities) HasProvAddr(s string) bool { return slices.Contains(e.ProvAddrs, s) }
+
+// HasSessionID reports whether the id is one of the sessions.
+func (e *Entities) HasSessionID(id uint64) bool { return slices.Contains(e.SessionIDs, id) }
+
+// HasSubscriptionID reports whether the id is one of the subscriptions.
+func (e *Entities) HasSubscriptionID(id uint64) bool { return slices.Contains(e.SubscriptionIDs, id) }
+
+func appendUnique[T comparable](items []T, v T) []T {
+	if slices.Contains(items, v) {
+		return items
+	}
+
+	return append(items, v)
+}
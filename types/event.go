@@ -2,7 +2,10 @@ package types
 
 const (
 	EventTypeDepositAdd                          = "Deposit.Add"
+	EventTypeDepositSnapshot                     = "Deposit.Snapshot"
 	EventTypeDepositSubtract                     = "Deposit.Subtract"
+	EventTypeLeaseUpdateDetails                  = "Lease.UpdateDetails"
+	EventTypeLeaseUpdateStatus                   = "Lease.UpdateStatus"
 	EventTypeNodeUpdateDetails                   = "Node.UpdateDetails"
 	EventTypeNodeUpdateStatus                    = "Node.UpdateStatus"
 	EventTypePlanUpdateStatus                    = "Plan.UpdateStatus"
@@ -0,0 +1,107 @@
+package gov
+
+import (
+	"fmt"
+	"strconv"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+// StatusDropped is the status of the proposals which did not reach the minimum
+// deposit in time. The module deletes such proposals instead of giving them a
+// status of their own.
+const StatusDropped = "PROPOSAL_STATUS_DROPPED"
+
+var (
+	StatusDepositPeriod = govtypes.StatusDepositPeriod.String()
+	StatusVotingPeriod  = govtypes.StatusVotingPeriod.String()
+)
+
+type EventSubmitProposal struct {
+	ProposalID uint64
+}
+
+func NewEventSubmitProposal(v *types.Event) (*EventSubmitProposal, error) {
+	proposalID, err := strconv.ParseUint(v.Attributes[govtypes.AttributeKeyProposalID], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventSubmitProposal{
+		ProposalID: proposalID,
+	}, nil
+}
+
+// NewEventSubmitProposalFromEvents skips the submit_proposal events without a
+// proposal ID, which the module emits once more per message with the type of
+// the proposal.
+func NewEventSubmitProposalFromEvents(v types.Events, skip int) (int, *EventSubmitProposal, error) {
+	for {
+		i, e, err := v.Get(govtypes.EventTypeSubmitProposal, skip)
+		if err != nil {
+			return 0, nil, err
+		}
+		if _, ok := e.Attributes[govtypes.AttributeKeyProposalID]; !ok {
+			skip = i + 1
+			continue
+		}
+
+		item, err := NewEventSubmitProposal(e)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		return i, item, nil
+	}
+}
+
+// IsVotingPeriodStarted reports whether the events move the proposal into its
+// voting period.
+func IsVotingPeriodStarted(v types.Events, proposalID uint64) bool {
+	id := strconv.FormatUint(proposalID, 10)
+	for _, e := range v {
+		if e.Type != govtypes.EventTypeSubmitProposal && e.Type != govtypes.EventTypeProposalDeposit {
+			continue
+		}
+		if e.Attributes[govtypes.AttributeKeyVotingPeriodStart] == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+type EventProposalResult struct {
+	ProposalID uint64
+	Status     string
+}
+
+// NewEventProposalResult parses both the active_proposal and inactive_proposal
+// events, which the module emits once the voting or deposit period ends.
+func NewEventProposalResult(v *types.Event) (*EventProposalResult, error) {
+	proposalID, err := strconv.ParseUint(v.Attributes[govtypes.AttributeKeyProposalID], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var status string
+	switch result := v.Attributes[govtypes.AttributeKeyProposalResult]; result {
+	case govtypes.AttributeValueProposalDropped:
+		status = StatusDropped
+	case govtypes.AttributeValueProposalPassed:
+		status = govtypes.StatusPassed.String()
+	case govtypes.AttributeValueProposalRejected:
+		status = govtypes.StatusRejected.String()
+	case govtypes.AttributeValueProposalFailed:
+		status = govtypes.StatusFailed.String()
+	default:
+		return nil, fmt.Errorf("invalid proposal result %s", result)
+	}
+
+	return &EventProposalResult{
+		ProposalID: proposalID,
+		Status:     status,
+	}, nil
+}
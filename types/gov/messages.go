@@ -0,0 +1,144 @@
+package gov
+
+import (
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+func coinsFromInterface(v interface{}) (types.Coins, error) {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var coins sdk.Coins
+	if err := json.Unmarshal(buf, &coins); err != nil {
+		return nil, err
+	}
+
+	return types.NewCoins(coins), nil
+}
+
+type MsgSubmitProposal struct {
+	Content        bson.M
+	ContentType    string
+	Title          string
+	Description    string
+	InitialDeposit types.Coins
+	Proposer       string
+}
+
+func NewMsgSubmitProposal(v bson.M) (*MsgSubmitProposal, error) {
+	buf, err := json.Marshal(v["content"])
+	if err != nil {
+		return nil, err
+	}
+
+	var content bson.M
+	if err := json.Unmarshal(buf, &content); err != nil {
+		return nil, err
+	}
+
+	contentType, _ := content["@type"].(string)
+	delete(content, "@type")
+
+	title, _ := content["title"].(string)
+	description, _ := content["description"].(string)
+
+	initialDeposit, err := coinsFromInterface(v["initial_deposit"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitProposal{
+		Content:        content,
+		ContentType:    contentType,
+		Title:          title,
+		Description:    description,
+		InitialDeposit: initialDeposit,
+		Proposer:       v["proposer"].(string),
+	}, nil
+}
+
+type MsgDeposit struct {
+	ProposalID uint64
+	Depositor  string
+	Amount     types.Coins
+}
+
+func NewMsgDeposit(v bson.M) (*MsgDeposit, error) {
+	proposalID, err := strconv.ParseUint(v["proposal_id"].(string), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := coinsFromInterface(v["amount"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgDeposit{
+		ProposalID: proposalID,
+		Depositor:  v["depositor"].(string),
+		Amount:     amount,
+	}, nil
+}
+
+type WeightedVoteOption struct {
+	Option string `json:"option,omitempty" bson:"option"`
+	Weight string `json:"weight,omitempty" bson:"weight"`
+}
+
+type MsgVote struct {
+	ProposalID uint64
+	Voter      string
+	Options    []*WeightedVoteOption
+}
+
+func NewMsgVote(v bson.M) (*MsgVote, error) {
+	proposalID, err := strconv.ParseUint(v["proposal_id"].(string), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgVote{
+		ProposalID: proposalID,
+		Voter:      v["voter"].(string),
+		Options: []*WeightedVoteOption{
+			{
+				Option: v["option"].(string),
+				Weight: sdk.OneDec().String(),
+			},
+		},
+	}, nil
+}
+
+// NewMsgVoteWeighted returns the weighted vote as a MsgVote, since a plain vote
+// is a weighted vote with a single option.
+func NewMsgVoteWeighted(v bson.M) (*MsgVote, error) {
+	proposalID, err := strconv.ParseUint(v["proposal_id"].(string), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := json.Marshal(v["options"])
+	if err != nil {
+		return nil, err
+	}
+
+	var options []*WeightedVoteOption
+	if err := json.Unmarshal(buf, &options); err != nil {
+		return nil, err
+	}
+
+	return &MsgVote{
+		ProposalID: proposalID,
+		Voter:      v["voter"].(string),
+		Options:    options,
+	}, nil
+}
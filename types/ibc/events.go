@@ -0,0 +1,70 @@
+package ibc
+
+import (
+	"strconv"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+type EventSendPacket struct {
+	Sequence           uint64
+	SourcePort         string
+	SourceChannel      string
+	DestinationPort    string
+	DestinationChannel string
+	Data               *FungibleTokenPacketData
+}
+
+func NewEventSendPacket(v *types.Event) (*EventSendPacket, error) {
+	sequence, err := strconv.ParseUint(v.Attributes["packet_sequence"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := NewFungibleTokenPacketData([]byte(v.Attributes["packet_data"]))
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventSendPacket{
+		Sequence:           sequence,
+		SourcePort:         v.Attributes["packet_src_port"],
+		SourceChannel:      v.Attributes["packet_src_channel"],
+		DestinationPort:    v.Attributes["packet_dst_port"],
+		DestinationChannel: v.Attributes["packet_dst_channel"],
+		Data:               data,
+	}, nil
+}
+
+func NewEventSendPacketFromEvents(v types.Events, skip int) (int, *EventSendPacket, error) {
+	i, e, err := v.Get("send_packet", skip)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	item, err := NewEventSendPacket(e)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return i, item, nil
+}
+
+// FindPacketEvent returns the event of the type emitted for the packet. The
+// core module handles a packet relayed more than once as a no-op without any
+// events, so a missing event means the message changed nothing.
+func FindPacketEvent(v types.Events, s string, packet *Packet) *types.Event {
+	sequence := strconv.FormatUint(packet.Sequence, 10)
+	for _, e := range v {
+		if e.Type != s {
+			continue
+		}
+		if e.Attributes["packet_sequence"] == sequence &&
+			e.Attributes["packet_src_port"] == packet.SourcePort &&
+			e.Attributes["packet_src_channel"] == packet.SourceChannel {
+			return e
+		}
+	}
+
+	return nil
+}
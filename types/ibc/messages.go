@@ -0,0 +1,155 @@
+package ibc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// FungibleTokenPacketData is the data of the packets of the transfer
+// application, where the denom carries the full trace path of the coin.
+type FungibleTokenPacketData struct {
+	Denom    string `json:"denom"`
+	Amount   string `json:"amount"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Memo     string `json:"memo"`
+}
+
+func NewFungibleTokenPacketData(v []byte) (*FungibleTokenPacketData, error) {
+	var data FungibleTokenPacketData
+	if err := json.Unmarshal(v, &data); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// Acknowledgement is the acknowledgement written for a received packet, which
+// holds either a result or an error.
+type Acknowledgement struct {
+	Result []byte `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+func NewAcknowledgement(v []byte) (*Acknowledgement, error) {
+	var ack Acknowledgement
+	if err := json.Unmarshal(v, &ack); err != nil {
+		return nil, err
+	}
+
+	return &ack, nil
+}
+
+func (a *Acknowledgement) Success() bool {
+	return a.Error == ""
+}
+
+type Packet struct {
+	Sequence           uint64
+	SourcePort         string
+	SourceChannel      string
+	DestinationPort    string
+	DestinationChannel string
+	Data               []byte
+}
+
+func NewPacket(v interface{}) (*Packet, error) {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var packet struct {
+		Sequence           string `json:"sequence"`
+		SourcePort         string `json:"source_port"`
+		SourceChannel      string `json:"source_channel"`
+		DestinationPort    string `json:"destination_port"`
+		DestinationChannel string `json:"destination_channel"`
+		Data               []byte `json:"data"`
+	}
+	if err := json.Unmarshal(buf, &packet); err != nil {
+		return nil, err
+	}
+
+	sequence, err := strconv.ParseUint(packet.Sequence, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Packet{
+		Sequence:           sequence,
+		SourcePort:         packet.SourcePort,
+		SourceChannel:      packet.SourceChannel,
+		DestinationPort:    packet.DestinationPort,
+		DestinationChannel: packet.DestinationChannel,
+		Data:               packet.Data,
+	}, nil
+}
+
+type MsgRecvPacket struct {
+	Packet *Packet
+	Signer string
+}
+
+func NewMsgRecvPacket(v bson.M) (*MsgRecvPacket, error) {
+	packet, err := NewPacket(v["packet"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRecvPacket{
+		Packet: packet,
+		Signer: v["signer"].(string),
+	}, nil
+}
+
+type MsgAcknowledgement struct {
+	Packet          *Packet
+	Acknowledgement *Acknowledgement
+	Signer          string
+}
+
+func NewMsgAcknowledgement(v bson.M) (*MsgAcknowledgement, error) {
+	packet, err := NewPacket(v["packet"])
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := base64.StdEncoding.DecodeString(v["acknowledgement"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	ack, err := NewAcknowledgement(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgAcknowledgement{
+		Packet:          packet,
+		Acknowledgement: ack,
+		Signer:          v["signer"].(string),
+	}, nil
+}
+
+// MsgTimeout is either a MsgTimeout or a MsgTimeoutOnClose, which only differ
+// in the proofs.
+type MsgTimeout struct {
+	Packet *Packet
+	Signer string
+}
+
+func NewMsgTimeout(v bson.M) (*MsgTimeout, error) {
+	packet, err := NewPacket(v["packet"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgTimeout{
+		Packet: packet,
+		Signer: v["signer"].(string),
+	}, nil
+}
@@ -0,0 +1,187 @@
+package lease
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+type EventCreate struct {
+	ID                 uint64
+	NodeAddress        string
+	ProvAddress        string
+	MaxHours           int64
+	Price              *types.Coin
+	RenewalPricePolicy string
+}
+
+func NewEventCreate(v *types.Event) (*EventCreate, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	maxHours, err := strconv.ParseInt(v.Attributes["max_hours"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := types.ParseCoinAttribute(v.Attributes["price"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventCreate{
+		ID:                 id,
+		NodeAddress:        v.Attributes["node_address"],
+		ProvAddress:        v.Attributes["prov_address"],
+		MaxHours:           maxHours,
+		Price:              price,
+		RenewalPricePolicy: v.Attributes["renewal_price_policy"],
+	}, nil
+}
+
+type EventEnd struct {
+	ID          uint64
+	NodeAddress string
+	ProvAddress string
+}
+
+func NewEventEnd(v *types.Event) (*EventEnd, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventEnd{
+		ID:          id,
+		NodeAddress: v.Attributes["node_address"],
+		ProvAddress: v.Attributes["prov_address"],
+	}, nil
+}
+
+type EventPay struct {
+	ID            uint64
+	NodeAddress   string
+	ProvAddress   string
+	Payment       *types.Coin
+	StakingReward *types.Coin
+}
+
+func NewEventPay(v *types.Event) (*EventPay, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := types.ParseCoinAttribute(v.Attributes["payment"])
+	if err != nil {
+		return nil, err
+	}
+
+	stakingReward, err := types.ParseCoinAttribute(v.Attributes["staking_reward"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventPay{
+		ID:            id,
+		NodeAddress:   v.Attributes["node_address"],
+		ProvAddress:   v.Attributes["prov_address"],
+		Payment:       payment,
+		StakingReward: stakingReward,
+	}, nil
+}
+
+type EventRefund struct {
+	ID          uint64
+	ProvAddress string
+	Amount      *types.Coin
+}
+
+func NewEventRefund(v *types.Event) (*EventRefund, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := types.ParseCoinAttribute(v.Attributes["amount"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventRefund{
+		ID:          id,
+		ProvAddress: v.Attributes["prov_address"],
+		Amount:      amount,
+	}, nil
+}
+
+type EventRenew struct {
+	ID          uint64
+	NodeAddress string
+	ProvAddress string
+	MaxHours    int64
+	Price       *types.Coin
+}
+
+func NewEventRenew(v *types.Event) (*EventRenew, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	maxHours, err := strconv.ParseInt(v.Attributes["max_hours"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := types.ParseCoinAttribute(v.Attributes["price"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventRenew{
+		ID:          id,
+		NodeAddress: v.Attributes["node_address"],
+		ProvAddress: v.Attributes["prov_address"],
+		MaxHours:    maxHours,
+		Price:       price,
+	}, nil
+}
+
+type EventUpdate struct {
+	ID          uint64
+	NodeAddress string
+	ProvAddress string
+	Hours       int64
+	PayoutAt    time.Time
+}
+
+func NewEventUpdate(v *types.Event) (*EventUpdate, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	hours, err := strconv.ParseInt(v.Attributes["hours"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var payoutAt time.Time
+	if s := v.Attributes["payout_at"]; s != "" {
+		if payoutAt, err = time.Parse(time.RFC3339Nano, s); err != nil {
+			return nil, err
+		}
+	}
+
+	return &EventUpdate{
+		ID:          id,
+		NodeAddress: v.Attributes["node_address"],
+		ProvAddress: v.Attributes["prov_address"],
+		Hours:       hours,
+		PayoutAt:    payoutAt,
+	}, nil
+}
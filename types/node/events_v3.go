@@ -0,0 +1,165 @@
+package node
+
+import (
+	"strconv"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+type EventCreateV3 struct {
+	NodeAddress    string
+	GigabytePrices types.Coins
+	HourlyPrices   types.Coins
+	RemoteURL      string
+}
+
+func NewEventCreateV3(v *types.Event) (*EventCreateV3, error) {
+	gigabytePrices, err := types.ParseCoinsAttribute(v.Attributes["gigabyte_prices"])
+	if err != nil {
+		return nil, err
+	}
+
+	hourlyPrices, err := types.ParseCoinsAttribute(v.Attributes["hourly_prices"])
+	if err != nil {
+		return nil, err
+	}
+
+	remoteAddrs, err := types.ParseStringsAttribute(v.Attributes["remote_addrs"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventCreateV3{
+		NodeAddress:    v.Attributes["node_address"],
+		GigabytePrices: gigabytePrices,
+		HourlyPrices:   hourlyPrices,
+		RemoteURL:      firstString(remoteAddrs),
+	}, nil
+}
+
+type EventUpdateDetailsV3 struct {
+	NodeAddress    string
+	GigabytePrices types.Coins
+	HourlyPrices   types.Coins
+	RemoteURL      string
+}
+
+func NewEventUpdateDetailsV3(v *types.Event) (*EventUpdateDetailsV3, error) {
+	gigabytePrices, err := types.ParseCoinsAttribute(v.Attributes["gigabyte_prices"])
+	if err != nil {
+		return nil, err
+	}
+
+	hourlyPrices, err := types.ParseCoinsAttribute(v.Attributes["hourly_prices"])
+	if err != nil {
+		return nil, err
+	}
+
+	remoteAddrs, err := types.ParseStringsAttribute(v.Attributes["remote_addrs"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventUpdateDetailsV3{
+		NodeAddress:    v.Attributes["node_address"],
+		GigabytePrices: gigabytePrices,
+		HourlyPrices:   hourlyPrices,
+		RemoteURL:      firstString(remoteAddrs),
+	}, nil
+}
+
+type EventUpdateStatusV3 struct {
+	NodeAddress string
+	Status      string
+}
+
+func NewEventUpdateStatusV3(v *types.Event) (*EventUpdateStatusV3, error) {
+	return &EventUpdateStatusV3{
+		NodeAddress: v.Attributes["node_address"],
+		Status:      v.Attributes["status"],
+	}, nil
+}
+
+type EventCreateSessionV3 struct {
+	ID          uint64
+	AccAddress  string
+	NodeAddress string
+}
+
+func NewEventCreateSessionV3(v *types.Event) (*EventCreateSessionV3, error) {
+	id, err := strconv.ParseUint(v.Attributes["session_id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventCreateSessionV3{
+		ID:          id,
+		AccAddress:  v.Attributes["acc_address"],
+		NodeAddress: v.Attributes["node_address"],
+	}, nil
+}
+
+type EventPayV3 struct {
+	ID            uint64
+	AccAddress    string
+	NodeAddress   string
+	Payment       *types.Coin
+	StakingReward *types.Coin
+}
+
+func NewEventPayV3(v *types.Event) (*EventPayV3, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := types.ParseCoinAttribute(v.Attributes["payment"])
+	if err != nil {
+		return nil, err
+	}
+
+	stakingReward, err := types.ParseCoinAttribute(v.Attributes["staking_reward"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventPayV3{
+		ID:            id,
+		AccAddress:    v.Attributes["acc_address"],
+		NodeAddress:   v.Attributes["node_address"],
+		Payment:       payment,
+		StakingReward: stakingReward,
+	}, nil
+}
+
+type EventRefundV3 struct {
+	ID         uint64
+	AccAddress string
+	Amount     *types.Coin
+}
+
+func NewEventRefundV3(v *types.Event) (*EventRefundV3, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := types.ParseCoinAttribute(v.Attributes["amount"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventRefundV3{
+		ID:         id,
+		AccAddress: v.Attributes["acc_address"],
+		Amount:     amount,
+	}, nil
+}
+
+func firstString(v []string) string {
+	if len(v) == 0 {
+		return ""
+	}
+
+	return v[0]
+}
@@ -0,0 +1,97 @@
+package plan
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+type EventCreateV3 struct {
+	ID          uint64
+	ProvAddress string
+	Gigabytes   int64
+	Duration    int64
+	Prices      types.Coins
+}
+
+func NewEventCreateV3(v *types.Event) (*EventCreateV3, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	gigabytes, err := strconv.ParseInt(v.Attributes["gigabytes"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	hours, err := strconv.ParseInt(v.Attributes["hours"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := types.ParseCoinsAttribute(v.Attributes["prices"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventCreateV3{
+		ID:          id,
+		ProvAddress: v.Attributes["prov_address"],
+		Gigabytes:   gigabytes,
+		Duration:    (time.Duration(hours) * time.Hour).Nanoseconds(),
+		Prices:      prices,
+	}, nil
+}
+
+type EventLinkNodeV3 struct {
+	ID          uint64
+	NodeAddress string
+}
+
+func NewEventLinkNodeV3(v *types.Event) (*EventLinkNodeV3, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventLinkNodeV3{
+		ID:          id,
+		NodeAddress: v.Attributes["node_address"],
+	}, nil
+}
+
+type EventUnlinkNodeV3 struct {
+	ID          uint64
+	NodeAddress string
+}
+
+func NewEventUnlinkNodeV3(v *types.Event) (*EventUnlinkNodeV3, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventUnlinkNodeV3{
+		ID:          id,
+		NodeAddress: v.Attributes["node_address"],
+	}, nil
+}
+
+type EventUpdateV3 struct {
+	ID     uint64
+	Status string
+}
+
+func NewEventUpdateV3(v *types.Event) (*EventUpdateV3, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventUpdateV3{
+		ID:     id,
+		Status: v.Attributes["status"],
+	}, nil
+}
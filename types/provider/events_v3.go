@@ -0,0 +1,53 @@
+package node
+
+import (
+	"github.com/sentinel-official/explorer/types"
+)
+
+type EventCreateV3 struct {
+	ProvAddress string
+	Name        string
+	Identity    string
+	Website     string
+	Description string
+}
+
+func NewEventCreateV3(v *types.Event) (*EventCreateV3, error) {
+	return &EventCreateV3{
+		ProvAddress: v.Attributes["prov_address"],
+		Name:        v.Attributes["name"],
+		Identity:    v.Attributes["identity"],
+		Website:     v.Attributes["website"],
+		Description: v.Attributes["description"],
+	}, nil
+}
+
+type EventUpdateDetailsV3 struct {
+	ProvAddress string
+	Name        string
+	Identity    string
+	Website     string
+	Description string
+}
+
+func NewEventUpdateDetailsV3(v *types.Event) (*EventUpdateDetailsV3, error) {
+	return &EventUpdateDetailsV3{
+		ProvAddress: v.Attributes["prov_address"],
+		Name:        v.Attributes["name"],
+		Identity:    v.Attributes["identity"],
+		Website:     v.Attributes["website"],
+		Description: v.Attributes["description"],
+	}, nil
+}
+
+type EventUpdateStatusV3 struct {
+	ProvAddress string
+	Status      string
+}
+
+func NewEventUpdateStatusV3(v *types.Event) (*EventUpdateStatusV3, error) {
+	return &EventUpdateStatusV3{
+		ProvAddress: v.Attributes["prov_address"],
+		Status:      v.Attributes["status"],
+	}, nil
+}
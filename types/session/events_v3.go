@@ -0,0 +1,52 @@
+package session
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+type EventUpdateDetailsV3 struct {
+	ID        uint64
+	Bandwidth *types.Bandwidth
+	Duration  int64
+}
+
+func NewEventUpdateDetailsV3(v *types.Event) (*EventUpdateDetailsV3, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := time.ParseDuration(v.Attributes["duration"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventUpdateDetailsV3{
+		ID: id,
+		Bandwidth: &types.Bandwidth{
+			Download: v.Attributes["download_bytes"],
+			Upload:   v.Attributes["upload_bytes"],
+		},
+		Duration: duration.Nanoseconds(),
+	}, nil
+}
+
+type EventUpdateStatusV3 struct {
+	ID     uint64
+	Status string
+}
+
+func NewEventUpdateStatusV3(v *types.Event) (*EventUpdateStatusV3, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventUpdateStatusV3{
+		ID:     id,
+		Status: v.Attributes["status"],
+	}, nil
+}
@@ -0,0 +1,113 @@
+package staking
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+type EventUnbond struct {
+	Validator      string
+	Amount         *types.Coin
+	CompletionTime time.Time
+}
+
+func NewEventUnbond(v *types.Event) (*EventUnbond, error) {
+	amount, err := sdk.ParseCoinNormalized(v.Attributes["amount"])
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, err := time.Parse(time.RFC3339Nano, v.Attributes["completion_time"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventUnbond{
+		Validator:      v.Attributes["validator"],
+		Amount:         types.NewCoin(&amount),
+		CompletionTime: completionTime,
+	}, nil
+}
+
+func NewEventUnbondFromEvents(v types.Events, skip int) (int, *EventUnbond, error) {
+	i, e, err := v.Get("unbond", skip)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	item, err := NewEventUnbond(e)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return i, item, nil
+}
+
+type EventRedelegate struct {
+	SourceValidator      string
+	DestinationValidator string
+	Amount               *types.Coin
+	CompletionTime       time.Time
+}
+
+func NewEventRedelegate(v *types.Event) (*EventRedelegate, error) {
+	amount, err := sdk.ParseCoinNormalized(v.Attributes["amount"])
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, err := time.Parse(time.RFC3339Nano, v.Attributes["completion_time"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventRedelegate{
+		SourceValidator:      v.Attributes["source_validator"],
+		DestinationValidator: v.Attributes["destination_validator"],
+		Amount:               types.NewCoin(&amount),
+		CompletionTime:       completionTime,
+	}, nil
+}
+
+func NewEventRedelegateFromEvents(v types.Events, skip int) (int, *EventRedelegate, error) {
+	i, e, err := v.Get("redelegate", skip)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	item, err := NewEventRedelegate(e)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return i, item, nil
+}
+
+type EventCompleteUnbonding struct {
+	Validator string
+	Delegator string
+}
+
+func NewEventCompleteUnbonding(v *types.Event) (*EventCompleteUnbonding, error) {
+	return &EventCompleteUnbonding{
+		Validator: v.Attributes["validator"],
+		Delegator: v.Attributes["delegator"],
+	}, nil
+}
+
+type EventCompleteRedelegation struct {
+	SourceValidator      string
+	DestinationValidator string
+	Delegator            string
+}
+
+func NewEventCompleteRedelegation(v *types.Event) (*EventCompleteRedelegation, error) {
+	return &EventCompleteRedelegation{
+		SourceValidator:      v.Attributes["source_validator"],
+		DestinationValidator: v.Attributes["destination_validator"],
+		Delegator:            v.Attributes["delegator"],
+	}, nil
+}
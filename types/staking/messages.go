@@ -0,0 +1,102 @@
+package staking
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+func coinFromInterface(v interface{}) (*types.Coin, error) {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var coin sdk.Coin
+	if err := json.Unmarshal(buf, &coin); err != nil {
+		return nil, err
+	}
+
+	return types.NewCoin(&coin), nil
+}
+
+type MsgCreateValidator struct {
+	DelegatorAddress string
+	ValidatorAddress string
+	Value            *types.Coin
+}
+
+func NewMsgCreateValidator(v bson.M) (*MsgCreateValidator, error) {
+	value, err := coinFromInterface(v["value"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateValidator{
+		DelegatorAddress: v["delegator_address"].(string),
+		ValidatorAddress: v["validator_address"].(string),
+		Value:            value,
+	}, nil
+}
+
+type MsgDelegate struct {
+	DelegatorAddress string
+	ValidatorAddress string
+	Amount           *types.Coin
+}
+
+func NewMsgDelegate(v bson.M) (*MsgDelegate, error) {
+	amount, err := coinFromInterface(v["amount"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgDelegate{
+		DelegatorAddress: v["delegator_address"].(string),
+		ValidatorAddress: v["validator_address"].(string),
+		Amount:           amount,
+	}, nil
+}
+
+type MsgUndelegate struct {
+	DelegatorAddress string
+	ValidatorAddress string
+	Amount           *types.Coin
+}
+
+func NewMsgUndelegate(v bson.M) (*MsgUndelegate, error) {
+	amount, err := coinFromInterface(v["amount"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgUndelegate{
+		DelegatorAddress: v["delegator_address"].(string),
+		ValidatorAddress: v["validator_address"].(string),
+		Amount:           amount,
+	}, nil
+}
+
+type MsgBeginRedelegate struct {
+	DelegatorAddress    string
+	ValidatorSrcAddress string
+	ValidatorDstAddress string
+	Amount              *types.Coin
+}
+
+func NewMsgBeginRedelegate(v bson.M) (*MsgBeginRedelegate, error) {
+	amount, err := coinFromInterface(v["amount"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgBeginRedelegate{
+		DelegatorAddress:    v["delegator_address"].(string),
+		ValidatorSrcAddress: v["validator_src_address"].(string),
+		ValidatorDstAddress: v["validator_dst_address"].(string),
+		Amount:              amount,
+	}, nil
+}
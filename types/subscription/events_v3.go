@@ -0,0 +1,147 @@
+package subscription
+
+import (
+	"strconv"
+
+	"github.com/sentinel-official/explorer/types"
+)
+
+type EventCreateV3 struct {
+	ID         uint64
+	PlanID     uint64
+	AccAddress string
+	Price      *types.Coin
+}
+
+func NewEventCreateV3(v *types.Event) (*EventCreateV3, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	planID, err := strconv.ParseUint(v.Attributes["plan_id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := types.ParseCoinsAttribute(v.Attributes["price"])
+	if err != nil {
+		return nil, err
+	}
+
+	item := &EventCreateV3{
+		ID:         id,
+		PlanID:     planID,
+		AccAddress: v.Attributes["acc_address"],
+	}
+
+	if len(price) > 0 {
+		item.Price = price[0]
+	}
+
+	return item, nil
+}
+
+type EventUpdateV3 struct {
+	ID     uint64
+	Status string
+}
+
+func NewEventUpdateV3(v *types.Event) (*EventUpdateV3, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventUpdateV3{
+		ID:     id,
+		Status: v.Attributes["status"],
+	}, nil
+}
+
+type EventAllocateV3 struct {
+	ID            uint64
+	AccAddress    string
+	GrantedBytes  string
+	UtilisedBytes string
+}
+
+func NewEventAllocateV3(v *types.Event) (*EventAllocateV3, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventAllocateV3{
+		ID:            id,
+		AccAddress:    v.Attributes["acc_address"],
+		GrantedBytes:  v.Attributes["granted_bytes"],
+		UtilisedBytes: v.Attributes["utilised_bytes"],
+	}, nil
+}
+
+type EventCreateSessionV3 struct {
+	ID             uint64
+	SubscriptionID uint64
+	AccAddress     string
+	NodeAddress    string
+}
+
+func NewEventCreateSessionV3(v *types.Event) (*EventCreateSessionV3, error) {
+	id, err := strconv.ParseUint(v.Attributes["session_id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionID, err := strconv.ParseUint(v.Attributes["subscription_id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventCreateSessionV3{
+		ID:             id,
+		SubscriptionID: subscriptionID,
+		AccAddress:     v.Attributes["acc_address"],
+		NodeAddress:    v.Attributes["node_address"],
+	}, nil
+}
+
+type EventPayV3 struct {
+	ID            uint64
+	PlanID        uint64
+	AccAddress    string
+	ProvAddress   string
+	Payment       *types.Coin
+	StakingReward *types.Coin
+}
+
+func NewEventPayV3(v *types.Event) (*EventPayV3, error) {
+	id, err := strconv.ParseUint(v.Attributes["id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	planID, err := strconv.ParseUint(v.Attributes["plan_id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := types.ParseCoinAttribute(v.Attributes["payment"])
+	if err != nil {
+		return nil, err
+	}
+
+	stakingReward, err := types.ParseCoinAttribute(v.Attributes["staking_reward"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventPayV3{
+		ID:            id,
+		PlanID:        planID,
+		AccAddress:    v.Attributes["acc_address"],
+		ProvAddress:   v.Attributes["prov_address"],
+		Payment:       payment,
+		StakingReward: stakingReward,
+	}, nil
+}
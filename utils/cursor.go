@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"encoding/base64"
+	"errors"
+	"slices"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Cursor is the position of a document in a sorted list of documents. It holds
+// the values of the sort keys of the document, and whether it points to the
+// documents before it instead of the ones after it.
+type Cursor struct {
+	Keys    []string `bson:"k"`
+	Values  bson.A   `bson:"v"`
+	Reverse bool     `bson:"r,omitempty"`
+}
+
+// CursorSort returns the sort with the _id key appended, which makes the order
+// of the documents total so that a cursor points to exactly one position.
+func CursorSort(sort bson.D) bson.D {
+	for _, e := range sort {
+		if e.Key == "_id" {
+			return sort
+		}
+	}
+
+	items := make(bson.D, 0, len(sort)+1)
+	items = append(items, sort...)
+
+	return append(items, bson.E{Key: "_id", Value: 1})
+}
+
+// ParseQueryCursor decodes the opaque cursor token of a query and checks that
+// it was issued for the same sort.
+func ParseQueryCursor(sort bson.D, v string) (*Cursor, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	buf, err := base64.RawURLEncoding.DecodeString(v)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	var c Cursor
+	if err := bson.UnmarshalExtJSON(buf, true, &c); err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	var keys []string
+	for _, e := range CursorSort(sort) {
+		keys = append(keys, e.Key)
+	}
+
+	if !slices.Equal(c.Keys, keys) || len(c.Values) != len(keys) {
+		return nil, errors.New("cursor does not match the sort")
+	}
+
+	return &c, nil
+}
+
+// String returns the opaque token of the cursor.
+func (c *Cursor) String() string {
+	buf, err := bson.MarshalExtJSON(c, true, false)
+	if err != nil {
+		panic(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
@@ -0,0 +1,239 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// FieldType is the type the query values of a field are parsed as.
+type FieldType int
+
+const (
+	FieldTypeString FieldType = iota
+	FieldTypeInt
+	FieldTypeBool
+	FieldTypeTime
+)
+
+// Field is a document field which the query of a list request can filter or
+// sort on. Only the fields backed by an index of the collection should allow
+// filtering.
+type Field struct {
+	Key    string
+	Type   FieldType
+	Values []string
+	Filter bool
+	Sort   bool
+}
+
+// Fields are the fields of a list request, keyed by their query name. The
+// document key of a field defaults to its query name.
+type Fields map[string]*Field
+
+// EventFields are the fields of the lists of events, which are shared by the
+// events of all the collections.
+var EventFields = Fields{
+	"height":    {Type: FieldTypeInt, Sort: true},
+	"timestamp": {Type: FieldTypeTime, Filter: true, Sort: true},
+	"tx_hash":   {Type: FieldTypeString, Filter: true},
+}
+
+var (
+	filterOperators = map[FieldType][]string{
+		FieldTypeString: {"eq", "in"},
+		FieldTypeInt:    {"eq", "in", "gt", "gte", "lt", "lte"},
+		FieldTypeBool:   {"eq"},
+		FieldTypeTime:   {"gt", "gte", "lt", "lte"},
+	}
+	filterQueryKey = regexp.MustCompile(`^filter\[([a-z_.]+)\](?:\[([a-z]+)\])?$`)
+)
+
+func (f Fields) key(name string) string {
+	if f[name].Key != "" {
+		return f[name].Key
+	}
+
+	return name
+}
+
+// ParseQueryFilter returns the filter of the query parameters named as
+// filter[field] or filter[field][operator]. The eq operator is used when
+// none is given and the in operator takes a comma separated list.
+func (f Fields) ParseQueryFilter(query url.Values) (bson.M, error) {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	filter := bson.M{}
+	for _, key := range keys {
+		m := filterQueryKey.FindStringSubmatch(key)
+		if m == nil {
+			if strings.HasPrefix(key, "filter") {
+				return nil, fmt.Errorf("invalid filter parameter %s", key)
+			}
+
+			continue
+		}
+
+		name, op := m[1], m[2]
+		if op == "" {
+			op = "eq"
+		}
+
+		field, ok := f[name]
+		if !ok || !field.Filter {
+			return nil, fmt.Errorf("filter field must be one of %#v", f.names(func(v *Field) bool { return v.Filter }))
+		}
+		if !slices.Contains(filterOperators[field.Type], op) {
+			return nil, fmt.Errorf("filter operator of field %s must be one of %#v", name, filterOperators[field.Type])
+		}
+		if len(query[key]) != 1 {
+			return nil, fmt.Errorf("filter parameter %s is repeated", key)
+		}
+
+		s := query[key][0]
+		if op == "in" {
+			items := strings.Split(s, ",")
+			values := make(bson.A, 0, len(items))
+			for _, item := range items {
+				value, err := field.parse(item)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value of filter field %s: %w", name, err)
+				}
+
+				values = append(values, value)
+			}
+
+			if err := setFilter(filter, f.key(name), "$in", values); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		value, err := field.parse(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of filter field %s: %w", name, err)
+		}
+
+		if err := setFilter(filter, f.key(name), "$"+op, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return filter, nil
+}
+
+// ParseQuerySort returns the sort of a comma separated list of the fields, each
+// prefixed with a minus sign for the descending order.
+func (f Fields) ParseQuerySort(v string) (d bson.D, err error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	seen := make(map[string]bool)
+	for _, item := range strings.Split(v, ",") {
+		name, order := item, 1
+		if strings.HasPrefix(name, "-") {
+			name, order = name[1:], -1
+		}
+
+		field, ok := f[name]
+		if !ok || !field.Sort {
+			return nil, fmt.Errorf("sort field must be one of %#v", f.names(func(v *Field) bool { return v.Sort }))
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("sort field %s is repeated", name)
+		}
+
+		seen[name] = true
+		d = append(d, bson.E{Key: f.key(name), Value: order})
+	}
+
+	return d, nil
+}
+
+func (f Fields) names(fn func(v *Field) bool) []string {
+	var items []string
+	for name, field := range f {
+		if fn(field) {
+			items = append(items, name)
+		}
+	}
+
+	sort.Strings(items)
+	return items
+}
+
+func (f *Field) parse(s string) (interface{}, error) {
+	switch f.Type {
+	case FieldTypeInt:
+		return strconv.ParseInt(s, 10, 64)
+	case FieldTypeBool:
+		return strconv.ParseBool(s)
+	case FieldTypeTime:
+		return time.Parse(time.RFC3339, s)
+	default:
+		if len(f.Values) > 0 && !slices.Contains(f.Values, s) {
+			return nil, fmt.Errorf("value must be one of %#v", f.Values)
+		}
+
+		return s, nil
+	}
+}
+
+// setFilter adds the condition on the key to the filter, an equality can not
+// be combined with the other operators.
+func setFilter(filter bson.M, key, op string, value interface{}) error {
+	v, ok := filter[key]
+	if !ok {
+		if op == "$eq" {
+			filter[key] = value
+		} else {
+			filter[key] = bson.M{op: value}
+		}
+
+		return nil
+	}
+
+	m, ok := v.(bson.M)
+	if !ok || op == "$eq" {
+		return fmt.Errorf("filter of %s can not combine eq with other operators", key)
+	}
+
+	m[op] = value
+	return nil
+}
+
+// MergeFilter adds the conditions of the query filter to the filter of a
+// handler. A key present in both is matched against both conditions, so the
+// query can only narrow the documents the handler selects.
+func MergeFilter(filter, query bson.M) {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if _, ok := filter[key]; !ok {
+			filter[key] = query[key]
+			continue
+		}
+
+		and, _ := filter["$and"].(bson.A)
+		filter["$and"] = append(and, bson.M{key: query[key]})
+	}
+}
@@ -4,6 +4,10 @@ import (
 	"time"
 )
 
+func HourDate(v time.Time) time.Time {
+	return time.Date(v.Year(), v.Month(), v.Day(), v.Hour(), 0, 0, 0, v.Location())
+}
+
 func DayDate(v time.Time) time.Time {
 	return time.Date(v.Year(), v.Month(), v.Day(), 0, 0, 0, 0, v.Location())
 }